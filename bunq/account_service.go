@@ -1,20 +1,27 @@
 package bunq
 
 import (
+	"context"
 	"fmt"
-	"github.com/pkg/errors"
 	"net/http"
+
+	"github.com/pkg/errors"
 )
 
 type accountService service
 
 func (a *accountService) GetAllMonetaryAccountBank() (*ResponseMonetaryAccountBankGet, error) {
+	return a.GetAllMonetaryAccountBankCtx(context.Background())
+}
+
+// GetAllMonetaryAccountBankCtx is GetAllMonetaryAccountBank with a context for the request.
+func (a *accountService) GetAllMonetaryAccountBankCtx(ctx context.Context) (*ResponseMonetaryAccountBankGet, error) {
 	userID, err := a.client.GetUserID()
 	if err != nil {
 		return nil, err
 	}
 
-	res, err := a.client.preformRequest(http.MethodGet, a.client.formatRequestURL(fmt.Sprintf(endpointMonetaryAccountBankListing, userID)), nil)
+	res, err := a.client.preformRequest(ctx, http.MethodGet, a.client.formatRequestURL(fmt.Sprintf(endpointMonetaryAccountBankListing, userID)), nil)
 	if err != nil {
 		return nil, errors.Wrap(err, "bunq: request to get all MA bank failed")
 	}
@@ -25,12 +32,17 @@ func (a *accountService) GetAllMonetaryAccountBank() (*ResponseMonetaryAccountBa
 }
 
 func (a *accountService) GetMonetaryAccountBank(id int) (*ResponseMonetaryAccountBankGet, error) {
+	return a.GetMonetaryAccountBankCtx(context.Background(), id)
+}
+
+// GetMonetaryAccountBankCtx is GetMonetaryAccountBank with a context for the request.
+func (a *accountService) GetMonetaryAccountBankCtx(ctx context.Context, id int) (*ResponseMonetaryAccountBankGet, error) {
 	userID, err := a.client.GetUserID()
 	if err != nil {
 		return nil, err
 	}
 
-	res, err := a.client.preformRequest(http.MethodGet, a.client.formatRequestURL(fmt.Sprintf(endpointMonetaryAccountBankGet, userID, id)), nil)
+	res, err := a.client.preformRequest(ctx, http.MethodGet, a.client.formatRequestURL(fmt.Sprintf(endpointMonetaryAccountBankGet, userID, id)), nil)
 	if err != nil {
 		return nil, errors.Wrap(err, "bunq: request to get MA bank failed")
 	}
@@ -41,12 +53,17 @@ func (a *accountService) GetMonetaryAccountBank(id int) (*ResponseMonetaryAccoun
 }
 
 func (a *accountService) GetAllMonetaryAccountSaving() (*ResponseMonetaryAccountSavingGet, error) {
+	return a.GetAllMonetaryAccountSavingCtx(context.Background())
+}
+
+// GetAllMonetaryAccountSavingCtx is GetAllMonetaryAccountSaving with a context for the request.
+func (a *accountService) GetAllMonetaryAccountSavingCtx(ctx context.Context) (*ResponseMonetaryAccountSavingGet, error) {
 	userID, err := a.client.GetUserID()
 	if err != nil {
 		return nil, err
 	}
 
-	res, err := a.client.preformRequest(http.MethodGet, a.client.formatRequestURL(fmt.Sprintf(endpointMonetaryAccountSavingsListing, userID)), nil)
+	res, err := a.client.preformRequest(ctx, http.MethodGet, a.client.formatRequestURL(fmt.Sprintf(endpointMonetaryAccountSavingsListing, userID)), nil)
 	if err != nil {
 		return nil, errors.Wrap(err, "bunq: request to get all MA saving failed")
 	}
@@ -57,12 +74,17 @@ func (a *accountService) GetAllMonetaryAccountSaving() (*ResponseMonetaryAccount
 }
 
 func (a *accountService) GetMonetaryAccountSaving(id int) (*ResponseMonetaryAccountSavingGet, error) {
+	return a.GetMonetaryAccountSavingCtx(context.Background(), id)
+}
+
+// GetMonetaryAccountSavingCtx is GetMonetaryAccountSaving with a context for the request.
+func (a *accountService) GetMonetaryAccountSavingCtx(ctx context.Context, id int) (*ResponseMonetaryAccountSavingGet, error) {
 	userID, err := a.client.GetUserID()
 	if err != nil {
 		return nil, err
 	}
 
-	res, err := a.client.preformRequest(http.MethodGet, a.client.formatRequestURL(fmt.Sprintf(endpointMonetaryAccountSavingsGet, userID, id)), nil)
+	res, err := a.client.preformRequest(ctx, http.MethodGet, a.client.formatRequestURL(fmt.Sprintf(endpointMonetaryAccountSavingsGet, userID, id)), nil)
 	if err != nil {
 		return nil, errors.Wrap(err, "bunq: request to get MA saving failed")
 	}
@@ -71,3 +93,254 @@ func (a *accountService) GetMonetaryAccountSaving(id int) (*ResponseMonetaryAcco
 
 	return &resStruct, a.client.parseResponse(res, &resStruct)
 }
+
+// IterateMonetaryAccountBank returns an iterator over all bank accounts of the user.
+func (a *accountService) IterateMonetaryAccountBank(opts PageOptions) *MonetaryAccountBankIterator {
+	userID, err := a.client.GetUserID()
+	if err != nil {
+		return &MonetaryAccountBankIterator{it: newPageIteratorFromError(err)}
+	}
+
+	return &MonetaryAccountBankIterator{
+		it: newPageIterator(a.client, monetaryAccountKeyBank, fmt.Sprintf(endpointMonetaryAccountBankPath, userID), opts),
+	}
+}
+
+// IterateMonetaryAccountSaving returns an iterator over all savings accounts of the user.
+func (a *accountService) IterateMonetaryAccountSaving(opts PageOptions) *MonetaryAccountSavingIterator {
+	userID, err := a.client.GetUserID()
+	if err != nil {
+		return &MonetaryAccountSavingIterator{it: newPageIteratorFromError(err)}
+	}
+
+	return &MonetaryAccountSavingIterator{
+		it: newPageIterator(a.client, monetaryAccountKeySaving, fmt.Sprintf(endpointMonetaryAccountSavingsPath, userID), opts),
+	}
+}
+
+// CreateMonetaryAccountBank creates a new bank account.
+func (a *accountService) CreateMonetaryAccountBank(create MonetaryAccountBankCreate) (*responseBunqID, error) {
+	return a.CreateMonetaryAccountBankCtx(context.Background(), create)
+}
+
+// CreateMonetaryAccountBankCtx is CreateMonetaryAccountBank with a context for the request.
+func (a *accountService) CreateMonetaryAccountBankCtx(ctx context.Context, create MonetaryAccountBankCreate) (*responseBunqID, error) {
+	err := create.validate()
+	if err != nil {
+		return nil, err
+	}
+
+	return a.client.doUserCURequest(ctx, http.MethodPost, endpointMonetaryAccountBankPath, create)
+}
+
+// UpdateMonetaryAccountBank changes the set fields of a bank account.
+func (a *accountService) UpdateMonetaryAccountBank(id int, update MonetaryAccountBankUpdate) (*responseBunqID, error) {
+	return a.UpdateMonetaryAccountBankCtx(context.Background(), id, update)
+}
+
+// UpdateMonetaryAccountBankCtx is UpdateMonetaryAccountBank with a context for the request.
+func (a *accountService) UpdateMonetaryAccountBankCtx(ctx context.Context, id int, update MonetaryAccountBankUpdate) (*responseBunqID, error) {
+	err := update.validate()
+	if err != nil {
+		return nil, err
+	}
+
+	return a.client.doUserCURequest(ctx, http.MethodPut, endpointMonetaryAccountBankGet, update, id)
+}
+
+// CloseMonetaryAccountBank closes a bank account. The account must have a zero balance.
+func (a *accountService) CloseMonetaryAccountBank(id int, accountClose MonetaryAccountClose) (*responseBunqID, error) {
+	return a.CloseMonetaryAccountBankCtx(context.Background(), id, accountClose)
+}
+
+// CloseMonetaryAccountBankCtx is CloseMonetaryAccountBank with a context for the request.
+func (a *accountService) CloseMonetaryAccountBankCtx(ctx context.Context, id int, accountClose MonetaryAccountClose) (*responseBunqID, error) {
+	err := accountClose.validate()
+	if err != nil {
+		return nil, err
+	}
+
+	return a.client.doUserCURequest(ctx, http.MethodPut, endpointMonetaryAccountBankGet, newRequestMonetaryAccountClose(accountClose), id)
+}
+
+// CreateMonetaryAccountSaving creates a new savings account.
+func (a *accountService) CreateMonetaryAccountSaving(create MonetaryAccountSavingCreate) (*responseBunqID, error) {
+	return a.CreateMonetaryAccountSavingCtx(context.Background(), create)
+}
+
+// CreateMonetaryAccountSavingCtx is CreateMonetaryAccountSaving with a context for the request.
+func (a *accountService) CreateMonetaryAccountSavingCtx(ctx context.Context, create MonetaryAccountSavingCreate) (*responseBunqID, error) {
+	err := create.validate()
+	if err != nil {
+		return nil, err
+	}
+
+	return a.client.doUserCURequest(ctx, http.MethodPost, endpointMonetaryAccountSavingsPath, create)
+}
+
+// UpdateMonetaryAccountSaving changes the set fields of a savings account.
+func (a *accountService) UpdateMonetaryAccountSaving(id int, update MonetaryAccountSavingUpdate) (*responseBunqID, error) {
+	return a.UpdateMonetaryAccountSavingCtx(context.Background(), id, update)
+}
+
+// UpdateMonetaryAccountSavingCtx is UpdateMonetaryAccountSaving with a context for the request.
+func (a *accountService) UpdateMonetaryAccountSavingCtx(ctx context.Context, id int, update MonetaryAccountSavingUpdate) (*responseBunqID, error) {
+	err := update.validate()
+	if err != nil {
+		return nil, err
+	}
+
+	return a.client.doUserCURequest(ctx, http.MethodPut, endpointMonetaryAccountSavingsGet, update, id)
+}
+
+// CloseMonetaryAccountSaving closes a savings account. The account must have a zero balance.
+func (a *accountService) CloseMonetaryAccountSaving(id int, accountClose MonetaryAccountClose) (*responseBunqID, error) {
+	return a.CloseMonetaryAccountSavingCtx(context.Background(), id, accountClose)
+}
+
+// CloseMonetaryAccountSavingCtx is CloseMonetaryAccountSaving with a context for the request.
+func (a *accountService) CloseMonetaryAccountSavingCtx(ctx context.Context, id int, accountClose MonetaryAccountClose) (*responseBunqID, error) {
+	err := accountClose.validate()
+	if err != nil {
+		return nil, err
+	}
+
+	return a.client.doUserCURequest(ctx, http.MethodPut, endpointMonetaryAccountSavingsGet, newRequestMonetaryAccountClose(accountClose), id)
+}
+
+// CreateMonetaryAccountJoint creates a new joint account.
+func (a *accountService) CreateMonetaryAccountJoint(create MonetaryAccountJointCreate) (*responseBunqID, error) {
+	return a.CreateMonetaryAccountJointCtx(context.Background(), create)
+}
+
+// CreateMonetaryAccountJointCtx is CreateMonetaryAccountJoint with a context for the request.
+func (a *accountService) CreateMonetaryAccountJointCtx(ctx context.Context, create MonetaryAccountJointCreate) (*responseBunqID, error) {
+	err := create.validate()
+	if err != nil {
+		return nil, err
+	}
+
+	return a.client.doUserCURequest(ctx, http.MethodPost, endpointMonetaryAccountJointPath, create)
+}
+
+// UpdateMonetaryAccountJoint changes the set fields of a joint account.
+func (a *accountService) UpdateMonetaryAccountJoint(id int, update MonetaryAccountJointUpdate) (*responseBunqID, error) {
+	return a.UpdateMonetaryAccountJointCtx(context.Background(), id, update)
+}
+
+// UpdateMonetaryAccountJointCtx is UpdateMonetaryAccountJoint with a context for the request.
+func (a *accountService) UpdateMonetaryAccountJointCtx(ctx context.Context, id int, update MonetaryAccountJointUpdate) (*responseBunqID, error) {
+	err := update.validate()
+	if err != nil {
+		return nil, err
+	}
+
+	return a.client.doUserCURequest(ctx, http.MethodPut, endpointMonetaryAccountJointGet, update, id)
+}
+
+// CloseMonetaryAccountJoint closes a joint account. The account must have a zero balance.
+func (a *accountService) CloseMonetaryAccountJoint(id int, accountClose MonetaryAccountClose) (*responseBunqID, error) {
+	return a.CloseMonetaryAccountJointCtx(context.Background(), id, accountClose)
+}
+
+// CloseMonetaryAccountJointCtx is CloseMonetaryAccountJoint with a context for the request.
+func (a *accountService) CloseMonetaryAccountJointCtx(ctx context.Context, id int, accountClose MonetaryAccountClose) (*responseBunqID, error) {
+	err := accountClose.validate()
+	if err != nil {
+		return nil, err
+	}
+
+	return a.client.doUserCURequest(ctx, http.MethodPut, endpointMonetaryAccountJointGet, newRequestMonetaryAccountClose(accountClose), id)
+}
+
+// GetMonetaryAccountJoint returns the joint account with the given id.
+func (a *accountService) GetMonetaryAccountJoint(id int) (*ResponseMonetaryAccountJointGet, error) {
+	return a.GetMonetaryAccountJointCtx(context.Background(), id)
+}
+
+// GetMonetaryAccountJointCtx is GetMonetaryAccountJoint with a context for the request.
+func (a *accountService) GetMonetaryAccountJointCtx(ctx context.Context, id int) (*ResponseMonetaryAccountJointGet, error) {
+	userID, err := a.client.GetUserID()
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := a.client.preformRequest(ctx, http.MethodGet, a.client.formatRequestURL(fmt.Sprintf(endpointMonetaryAccountJointGet, userID, id)), nil)
+	if err != nil {
+		return nil, errors.Wrap(err, "bunq: request to get MA joint failed")
+	}
+
+	var resStruct ResponseMonetaryAccountJointGet
+
+	return &resStruct, a.client.parseResponse(res, &resStruct)
+}
+
+// IterateMonetaryAccountJoint returns an iterator over all joint accounts of the user.
+func (a *accountService) IterateMonetaryAccountJoint(opts PageOptions) *MonetaryAccountJointIterator {
+	userID, err := a.client.GetUserID()
+	if err != nil {
+		return &MonetaryAccountJointIterator{it: newPageIteratorFromError(err)}
+	}
+
+	return &MonetaryAccountJointIterator{
+		it: newPageIterator(a.client, monetaryAccountKeyJoint, fmt.Sprintf(endpointMonetaryAccountJointPath, userID), opts),
+	}
+}
+
+// GetAllMonetaryAccounts returns the first page of all accounts of the user, regardless of their type.
+func (a *accountService) GetAllMonetaryAccounts() (*ResponseMonetaryAccountGet, error) {
+	return a.GetAllMonetaryAccountsCtx(context.Background())
+}
+
+// GetAllMonetaryAccountsCtx is GetAllMonetaryAccounts with a context for the request.
+func (a *accountService) GetAllMonetaryAccountsCtx(ctx context.Context) (*ResponseMonetaryAccountGet, error) {
+	userID, err := a.client.GetUserID()
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := a.client.preformRequest(ctx, http.MethodGet, a.client.formatRequestURL(fmt.Sprintf(endpointMonetaryAccountListing, userID)), nil)
+	if err != nil {
+		return nil, errors.Wrap(err, "bunq: request to get all MA failed")
+	}
+
+	var resStruct ResponseMonetaryAccountGet
+
+	return &resStruct, a.client.parseResponse(res, &resStruct)
+}
+
+// IterateMonetaryAccounts returns an iterator over all accounts of the user, regardless of their type. Accounts of a
+// type this package does not know are returned as *MonetaryAccountUnknown.
+func (a *accountService) IterateMonetaryAccounts(opts PageOptions) *MonetaryAccountIterator {
+	userID, err := a.client.GetUserID()
+	if err != nil {
+		return &MonetaryAccountIterator{it: newPageIteratorFromError(err)}
+	}
+
+	it := newPageIterator(a.client, monetaryAccountKeyBank, fmt.Sprintf(endpointMonetaryAccountPath, userID), opts)
+	it.itemKeys = monetaryAccountKeys
+	it.keepUnknownItems = true
+
+	return &MonetaryAccountIterator{it: it}
+}
+
+// ListMonetaryAccounts returns all accounts of the user, regardless of their type.
+func (a *accountService) ListMonetaryAccounts() ([]MonetaryAccount, error) {
+	return a.ListMonetaryAccountsCtx(context.Background())
+}
+
+// ListMonetaryAccountsCtx is ListMonetaryAccounts with a context for the requests.
+func (a *accountService) ListMonetaryAccountsCtx(ctx context.Context) ([]MonetaryAccount, error) {
+	it := a.IterateMonetaryAccounts(PageOptions{})
+
+	var accounts []MonetaryAccount
+	for it.Next(ctx) {
+		accounts = append(accounts, it.Item())
+	}
+
+	if it.Err() != nil {
+		return nil, errors.Wrap(it.Err(), "bunq: could not list monetary accounts")
+	}
+
+	return accounts, nil
+}
@@ -1,6 +1,13 @@
 package bunq
 
 import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -67,3 +74,205 @@ func TestAccountService_GetMonetaryAccountSaving(t *testing.T) {
 	assert.NoError(t, err)
 	assert.NotZero(t, res.Response[0].MonetaryAccountSaving.ID)
 }
+
+func TestAccountService_CreateUpdateClose(t *testing.T) {
+	t.Parallel()
+
+	c, fakeServer, cancel := createClientWithFakeServer(t)
+	defer cancel()
+	defer fakeServer.Close()
+
+	assert.NoError(t, c.Init())
+
+	limit := Amount{Value: "1000.00", Currency: "EUR"}
+	setting := &MonetaryAccountSetting{Color: "#FE2851"}
+	closeWith := func(description string) MonetaryAccountClose {
+		return MonetaryAccountClose{
+			SubStatus:         MonetaryAccountSubStatusRedemptionVoluntary,
+			Reason:            MonetaryAccountCloseReasonOther,
+			ReasonDescription: description,
+		}
+	}
+
+	_, err := c.AccountService.CreateMonetaryAccountBank(MonetaryAccountBankCreate{Currency: "EUR", Description: "Bills", DailyLimit: &limit})
+	assert.NoError(t, err)
+	_, err = c.AccountService.UpdateMonetaryAccountBank(monetaryAccountID, MonetaryAccountBankUpdate{Setting: setting})
+	assert.NoError(t, err)
+	_, err = c.AccountService.CloseMonetaryAccountBank(monetaryAccountID, closeWith("no longer needed"))
+	assert.NoError(t, err)
+
+	_, err = c.AccountService.CreateMonetaryAccountSaving(MonetaryAccountSavingCreate{Currency: "EUR", Description: "Holiday", SavingsGoal: &limit})
+	assert.NoError(t, err)
+	_, err = c.AccountService.UpdateMonetaryAccountSaving(monetaryAccountID, MonetaryAccountSavingUpdate{SavingsGoal: &limit})
+	assert.NoError(t, err)
+	_, err = c.AccountService.CloseMonetaryAccountSaving(monetaryAccountID, closeWith("goal reached"))
+	assert.NoError(t, err)
+
+	_, err = c.AccountService.CreateMonetaryAccountJoint(MonetaryAccountJointCreate{
+		Currency:    "EUR",
+		Description: "Household",
+		AllCoOwner:  []CoOwnerCreate{{Alias: Pointer{PType: "EMAIL", Value: "bravo@bunq.com"}}},
+	})
+	assert.NoError(t, err)
+	_, err = c.AccountService.UpdateMonetaryAccountJoint(9700, MonetaryAccountJointUpdate{Description: "Home"})
+	assert.NoError(t, err)
+	_, err = c.AccountService.CloseMonetaryAccountJoint(9700, closeWith("moved out"))
+	assert.NoError(t, err)
+}
+
+func TestMonetaryAccountUpdateJSON(t *testing.T) {
+	t.Parallel()
+
+	raw, err := json.Marshal(MonetaryAccountJointUpdate{Description: "Home"})
+
+	assert.NoError(t, err)
+	assert.JSONEq(t, `{"description":"Home"}`, string(raw))
+}
+
+func TestAccountService_InvalidRequests(t *testing.T) {
+	t.Parallel()
+
+	var requests int32
+
+	handler := createBunqFakeHandler(t)
+	fakeServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if strings.Contains(r.URL.Path, "/monetary-account-") {
+			atomic.AddInt32(&requests, 1)
+		}
+
+		handler(w, r)
+	}))
+	defer fakeServer.Close()
+
+	key, err := CreateNewKeyPair()
+	assert.NoError(t, err)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	c := NewClient(ctx, fmt.Sprintf("%s/v1/", fakeServer.URL), key, "", "")
+	assert.NoError(t, c.Init())
+
+	limit := &Amount{Value: "10.5", Currency: "EUR"}
+
+	_, err = c.AccountService.CreateMonetaryAccountBank(MonetaryAccountBankCreate{Currency: "EUR", DailyLimit: limit})
+	assert.Error(t, err)
+	_, err = c.AccountService.UpdateMonetaryAccountBank(monetaryAccountID, MonetaryAccountBankUpdate{DailyLimit: limit})
+	assert.Error(t, err)
+	_, err = c.AccountService.CreateMonetaryAccountSaving(MonetaryAccountSavingCreate{Currency: "EUR", SavingsGoal: &Amount{Value: "10.000", Currency: "EUR"}})
+	assert.Error(t, err)
+	_, err = c.AccountService.UpdateMonetaryAccountJoint(9700, MonetaryAccountJointUpdate{DailyLimit: limit})
+	assert.Error(t, err)
+
+	_, err = c.AccountService.CloseMonetaryAccountBank(monetaryAccountID, MonetaryAccountClose{ReasonDescription: "no reason"})
+	assert.Error(t, err)
+
+	assert.Equal(t, int32(0), atomic.LoadInt32(&requests))
+}
+
+func TestAccountService_MonetaryAccountJoint(t *testing.T) {
+	t.Parallel()
+
+	c, fakeServer, cancel := createClientWithFakeServer(t)
+	defer cancel()
+	defer fakeServer.Close()
+
+	assert.NoError(t, c.Init())
+
+	res, err := c.AccountService.GetMonetaryAccountJoint(9700)
+	if assert.NoError(t, err) {
+		joint := res.Response[0].MonetaryAccountJoint
+		assert.Len(t, joint.AllCoOwner, 2)
+		assert.Equal(t, "NL12BUNQ9900123456", joint.GetIBANPointer().Value)
+	}
+
+	it := c.AccountService.IterateMonetaryAccountJoint(PageOptions{})
+
+	var ids []int
+	for it.Next(context.Background()) {
+		ids = append(ids, it.Item().ID)
+	}
+
+	assert.NoError(t, it.Err())
+	assert.Equal(t, []int{9700}, ids)
+}
+
+func TestAccountService_GetAllMonetaryAccounts(t *testing.T) {
+	t.Parallel()
+
+	c, fakeServer, cancel := createClientWithFakeServer(t)
+	defer cancel()
+	defer fakeServer.Close()
+
+	assert.NoError(t, c.Init())
+
+	res, err := c.AccountService.GetAllMonetaryAccounts()
+	if assert.NoError(t, err) && assert.Len(t, res.Response, 4) {
+		assert.NotNil(t, res.Response[0].MonetaryAccountBank)
+		assert.Nil(t, res.Response[0].MonetaryAccountSaving)
+		assert.Equal(t, 9521, res.Response[1].MonetaryAccountSaving.ID)
+		assert.Equal(t, 9700, res.Response[2].MonetaryAccountJoint.ID)
+
+		// Accounts of an unknown type are kept.
+		if assert.NotNil(t, res.Response[3].Unknown) {
+			assert.Equal(t, "MonetaryAccountLight", res.Response[3].Unknown.Type)
+			assert.Equal(t, 9800, res.Response[3].Unknown.ID)
+			assert.Contains(t, string(res.Response[3].Unknown.Raw), "balance_maximum")
+		}
+	}
+}
+
+func Test_requestMonetaryAccountClose(t *testing.T) {
+	t.Parallel()
+
+	raw, err := json.Marshal(newRequestMonetaryAccountClose(MonetaryAccountClose{
+		SubStatus:         MonetaryAccountSubStatusRedemptionVoluntary,
+		Reason:            MonetaryAccountCloseReasonOther,
+		ReasonDescription: "no longer needed",
+	}))
+
+	assert.NoError(t, err)
+	assert.JSONEq(t, `{"status":"CANCELLED","sub_status":"REDEMPTION_VOLUNTARY","reason":"OTHER","reason_description":"no longer needed"}`, string(raw))
+}
+
+func TestAccountService_ListMonetaryAccounts(t *testing.T) {
+	t.Parallel()
+
+	c, fakeServer, cancel := createClientWithFakeServer(t)
+	defer cancel()
+	defer fakeServer.Close()
+
+	assert.NoError(t, c.Init())
+
+	accounts, err := c.AccountService.ListMonetaryAccounts()
+	if !assert.NoError(t, err) || !assert.Len(t, accounts, 4) {
+		return
+	}
+
+	assert.IsType(t, &MonetaryAccountBank{}, accounts[0])
+	assert.IsType(t, &MonetaryAccountSaving{}, accounts[1])
+	assert.IsType(t, &MonetaryAccountJoint{}, accounts[2])
+	assert.IsType(t, &MonetaryAccountUnknown{}, accounts[3])
+
+	for _, acc := range accounts {
+		assert.NotZero(t, acc.GetID())
+		assert.NotEmpty(t, acc.GetStatus())
+		assert.NotNil(t, acc.GetIBANPointer())
+	}
+
+	assert.Equal(t, "NL12BUNQ9900123456", accounts[2].GetIBANPointer().Value)
+
+	if joint, ok := accounts[2].(*MonetaryAccountJoint); assert.True(t, ok) {
+		assert.Len(t, joint.AllCoOwner, 2)
+	}
+
+	if light, ok := accounts[3].(*MonetaryAccountUnknown); assert.True(t, ok) {
+		assert.Equal(t, "MonetaryAccountLight", light.Type)
+		assert.Equal(t, "25.00", light.GetBalance().Value)
+	}
+
+	res, err := c.AccountService.GetAllMonetaryAccounts()
+	if assert.NoError(t, err) {
+		assert.Equal(t, accounts, res.MonetaryAccounts())
+	}
+}
@@ -0,0 +1,196 @@
+package bunq
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+// ErrCurrencyMismatch is returned when two amounts with a different currency are combined.
+var ErrCurrencyMismatch = errors.New("bunq: currency mismatch")
+
+// NewAmount creates an amount from a value in cents, e.g. NewAmount(1050, "EUR") is 10.50 EUR.
+func NewAmount(cents int64, currency string) Amount {
+	sign := ""
+	abs := uint64(cents)
+
+	if cents < 0 {
+		sign = "-"
+		abs = uint64(-cents)
+	}
+
+	return Amount{
+		Value:    fmt.Sprintf("%s%d.%02d", sign, abs/100, abs%100),
+		Currency: currency,
+	}
+}
+
+// ParseAmount parses a decimal value with at most two decimals, e.g. "10.5", into an amount in bunq's
+// two decimal form, e.g. "10.50".
+func ParseAmount(value, currency string) (Amount, error) {
+	cents, err := parseCents(value, false)
+	if err != nil {
+		return Amount{}, err
+	}
+
+	return NewAmount(cents, currency), nil
+}
+
+// Cents returns the value of the amount in cents. The value must be in bunq's two decimal form.
+func (a Amount) Cents() (int64, error) {
+	return parseCents(a.Value, true)
+}
+
+// Validate returns an error if the value is not in bunq's two decimal form or the currency is missing.
+func (a Amount) Validate() error {
+	if a.Currency == "" {
+		return fmt.Errorf("bunq: amount %q has no currency", a.Value)
+	}
+
+	_, err := a.Cents()
+
+	return err
+}
+
+// validatePositive is Validate for amounts that are moved, like the amount of a payment, which bunq only
+// accepts when they are larger than zero. Validate itself accepts any sign as balances can be negative.
+func (a Amount) validatePositive() error {
+	err := a.Validate()
+	if err != nil {
+		return err
+	}
+
+	cents, _ := a.Cents()
+	if cents <= 0 {
+		return fmt.Errorf("bunq: amount %q is not positive", a.Value)
+	}
+
+	return nil
+}
+
+// Add returns a + b.
+func (a Amount) Add(b Amount) (Amount, error) {
+	x, y, err := centsOf(a, b)
+	if err != nil {
+		return Amount{}, err
+	}
+
+	if (y > 0 && x > math.MaxInt64-y) || (y < 0 && x < math.MinInt64-y) {
+		return Amount{}, errors.New("bunq: amount overflow")
+	}
+
+	return NewAmount(x+y, a.Currency), nil
+}
+
+// Sub returns a - b.
+func (a Amount) Sub(b Amount) (Amount, error) {
+	neg, err := b.Neg()
+	if err != nil {
+		return Amount{}, err
+	}
+
+	return a.Add(neg)
+}
+
+// Neg returns -a.
+func (a Amount) Neg() (Amount, error) {
+	cents, err := a.Cents()
+	if err != nil {
+		return Amount{}, err
+	}
+
+	if cents == math.MinInt64 {
+		return Amount{}, errors.New("bunq: amount overflow")
+	}
+
+	return NewAmount(-cents, a.Currency), nil
+}
+
+// Cmp compares a and b and returns -1 if a < b, 0 if a == b and 1 if a > b.
+func (a Amount) Cmp(b Amount) (int, error) {
+	x, y, err := centsOf(a, b)
+	if err != nil {
+		return 0, err
+	}
+
+	switch {
+	case x < y:
+		return -1, nil
+	case x > y:
+		return 1, nil
+	}
+
+	return 0, nil
+}
+
+// IsZero returns true if the value of the amount is zero.
+func (a Amount) IsZero() bool {
+	cents, err := a.Cents()
+
+	return err == nil && cents == 0
+}
+
+func centsOf(a, b Amount) (int64, int64, error) {
+	if a.Currency != b.Currency {
+		return 0, 0, errors.Wrap(ErrCurrencyMismatch, fmt.Sprintf("bunq: can not combine %s with %s", a.Currency, b.Currency))
+	}
+
+	x, err := a.Cents()
+	if err != nil {
+		return 0, 0, err
+	}
+
+	y, err := b.Cents()
+	if err != nil {
+		return 0, 0, err
+	}
+
+	return x, y, nil
+}
+
+// parseCents parses a decimal value into cents. When strict is set, the value must have exactly two decimals.
+func parseCents(value string, strict bool) (int64, error) {
+	invalid := fmt.Errorf("bunq: invalid amount %q, expected a value like \"10.50\"", value)
+
+	s := strings.TrimPrefix(value, "-")
+	negative := len(s) != len(value)
+
+	whole, fraction := s, ""
+	if i := strings.IndexByte(s, '.'); i >= 0 {
+		whole, fraction = s[:i], s[i+1:]
+	}
+
+	if whole == "" || len(fraction) > 2 || (strict && len(fraction) != 2) || (!strict && strings.HasSuffix(s, ".")) {
+		return 0, invalid
+	}
+
+	if !isDigits(whole) || !isDigits(fraction) {
+		return 0, invalid
+	}
+
+	fraction += strings.Repeat("0", 2-len(fraction))
+
+	cents, err := strconv.ParseInt(whole+fraction, 10, 64)
+	if err != nil {
+		return 0, invalid
+	}
+
+	if negative {
+		cents = -cents
+	}
+
+	return cents, nil
+}
+
+func isDigits(s string) bool {
+	for _, r := range s {
+		if r < '0' || r > '9' {
+			return false
+		}
+	}
+
+	return true
+}
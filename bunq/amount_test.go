@@ -0,0 +1,164 @@
+package bunq
+
+import (
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseAmount(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		value   string
+		want    string
+		wantErr bool
+	}{
+		{value: "10", want: "10.00"},
+		{value: "10.5", want: "10.50"},
+		{value: "10.50", want: "10.50"},
+		{value: "0.01", want: "0.01"},
+		{value: "-0.05", want: "-0.05"},
+		{value: "10.000", wantErr: true},
+		{value: "10.", wantErr: true},
+		{value: ".50", wantErr: true},
+		{value: "1e3", wantErr: true},
+		{value: "abc", wantErr: true},
+		{value: "", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		a, err := ParseAmount(tt.value, "EUR")
+
+		if tt.wantErr {
+			assert.Error(t, err, tt.value)
+			continue
+		}
+
+		if assert.NoError(t, err, tt.value) {
+			assert.Equal(t, Amount{Value: tt.want, Currency: "EUR"}, a)
+		}
+	}
+}
+
+func TestNewAmount(t *testing.T) {
+	t.Parallel()
+
+	assert.Equal(t, "10.50", NewAmount(1050, "EUR").Value)
+	assert.Equal(t, "0.00", NewAmount(0, "EUR").Value)
+	assert.Equal(t, "-0.05", NewAmount(-5, "EUR").Value)
+	assert.Equal(t, "-12.34", NewAmount(-1234, "EUR").Value)
+}
+
+func TestAmount_Cents(t *testing.T) {
+	t.Parallel()
+
+	cents, err := Amount{Value: "10.50", Currency: "EUR"}.Cents()
+
+	assert.NoError(t, err)
+	assert.Equal(t, int64(1050), cents)
+
+	_, err = Amount{Value: "10.5", Currency: "EUR"}.Cents()
+	assert.Error(t, err)
+
+	assert.Error(t, Amount{Value: "10.50"}.Validate())
+	assert.NoError(t, Amount{Value: "10.50", Currency: "EUR"}.Validate())
+}
+
+func TestAmount_Arithmetic(t *testing.T) {
+	t.Parallel()
+
+	a := NewAmount(1050, "EUR")
+	b := NewAmount(1075, "EUR")
+
+	sum, err := a.Add(b)
+	if assert.NoError(t, err) {
+		assert.Equal(t, "21.25", sum.Value)
+	}
+
+	diff, err := a.Sub(b)
+	if assert.NoError(t, err) {
+		assert.Equal(t, "-0.25", diff.Value)
+	}
+
+	neg, err := a.Neg()
+	if assert.NoError(t, err) {
+		assert.Equal(t, "-10.50", neg.Value)
+	}
+
+	cmp, err := a.Cmp(b)
+	if assert.NoError(t, err) {
+		assert.Equal(t, -1, cmp)
+	}
+
+	cmp, err = b.Cmp(a)
+	if assert.NoError(t, err) {
+		assert.Equal(t, 1, cmp)
+	}
+
+	cmp, err = a.Cmp(NewAmount(1050, "EUR"))
+	if assert.NoError(t, err) {
+		assert.Equal(t, 0, cmp)
+	}
+
+	assert.True(t, NewAmount(0, "EUR").IsZero())
+	assert.False(t, a.IsZero())
+
+	// 0.1 + 0.2 is not 0.30000000000000004.
+	sum, err = NewAmount(10, "EUR").Add(NewAmount(20, "EUR"))
+	if assert.NoError(t, err) {
+		assert.Equal(t, "0.30", sum.Value)
+	}
+}
+
+func TestAmount_CurrencyMismatch(t *testing.T) {
+	t.Parallel()
+
+	_, err := NewAmount(100, "EUR").Add(NewAmount(100, "USD"))
+	assert.True(t, errors.Is(err, ErrCurrencyMismatch))
+
+	_, err = NewAmount(100, "EUR").Sub(NewAmount(100, "USD"))
+	assert.True(t, errors.Is(err, ErrCurrencyMismatch))
+
+	_, err = NewAmount(100, "EUR").Cmp(NewAmount(100, "USD"))
+	assert.True(t, errors.Is(err, ErrCurrencyMismatch))
+}
+
+func TestCreatePaymentInvalidAmount(t *testing.T) {
+	t.Parallel()
+
+	c, fakeServer, cancel := createClientWithFakeServer(t)
+	defer cancel()
+	defer fakeServer.Close()
+
+	assert.NoError(t, c.Init())
+
+	_, err := c.PaymentService.CreatePayment(10111, PaymentCreate{
+		Amount:            Amount{Currency: "EUR", Value: "10.5"},
+		CounterpartyAlias: Pointer{PType: "EMAIL", Value: "bravo@bunq.com"},
+		Description:       "test",
+	})
+
+	assert.Error(t, err)
+
+	_, err = c.PaymentService.CreatePayment(10111, PaymentCreate{
+		Amount:            Amount{Currency: "EUR", Value: "-10.00"},
+		CounterpartyAlias: Pointer{PType: "EMAIL", Value: "bravo@bunq.com"},
+		Description:       "test",
+	})
+
+	assert.Error(t, err)
+}
+
+func TestAmount_validatePositive(t *testing.T) {
+	t.Parallel()
+
+	assert.NoError(t, NewAmount(1, "EUR").validatePositive())
+	assert.Error(t, NewAmount(0, "EUR").validatePositive())
+	assert.Error(t, NewAmount(-1000, "EUR").validatePositive())
+	assert.Error(t, RequestInquiryCreate{AmountInquired: NewAmount(0, "EUR")}.validate())
+
+	// Balances can be negative.
+	assert.NoError(t, NewAmount(-1000, "EUR").Validate())
+}
@@ -42,6 +42,10 @@ func createBunqFakeHandler(t *testing.T) http.HandlerFunc {
 			default:
 				t.Errorf(errorRequestToUnMockedHTTPMethod, r.URL, r.Method)
 			}
+		case "user-company/6084":
+			sendResponseWithSignatureForMethod(t, w, r, getUserCompanyGetResponse(t))
+		case "user/6084":
+			sendResponseWithSignature(t, w, http.StatusOK, getUserPersonGetResponse(t))
 		case "user/6084/monetary-account/9512/draft-payment":
 			switch r.Method {
 			case http.MethodPost:
@@ -50,9 +54,13 @@ func createBunqFakeHandler(t *testing.T) http.HandlerFunc {
 				t.Errorf(errorRequestToUnMockedHTTPMethod, r.URL, r.Method)
 			}
 		case "user/6084/monetary-account-bank", "user/6084/monetary-account-bank/9601":
-			sendResponseWithSignature(t, w, http.StatusOK, getMonetaryAccountBankGet(t))
+			sendResponseWithSignatureForMethod(t, w, r, getMonetaryAccountBankGet(t))
 		case "user/6084/monetary-account-savings", "user/6084/monetary-account-savings/9601":
-			sendResponseWithSignature(t, w, http.StatusOK, getMonetaryAccountSavings(t))
+			sendResponseWithSignatureForMethod(t, w, r, getMonetaryAccountSavings(t))
+		case "user/6084/monetary-account-joint", "user/6084/monetary-account-joint/9700":
+			sendResponseWithSignatureForMethod(t, w, r, getMonetaryAccountJointGet(t))
+		case "user/6084/monetary-account":
+			sendResponseWithSignature(t, w, http.StatusOK, getMonetaryAccountListing(t))
 		case "user/6084/monetary-account/9618/draft-payment", "user/6084/monetary-account/9618/draft-payment/6292":
 			switch r.Method {
 			case http.MethodPost, http.MethodPut:
@@ -65,12 +73,82 @@ func createBunqFakeHandler(t *testing.T) http.HandlerFunc {
 
 		case "user/6084/monetary-account/9520/mastercard-action/324":
 			sendResponseWithSignature(t, w, http.StatusOK, getMasterCardActionGet(t))
-		case "user/6084/monetary-account/10111/payment", "user/7082/monetary-account/10111/payment", "user/6084/monetary-account/10111/payment/1":
-			sendResponseWithSignature(t, w, http.StatusOK, getPaymentGet(t))
-		case "user/6084/monetary-account/9601/schedule-payment":
-			sendResponseWithSignature(t, w, http.StatusOK, getScheduledPaymentGet(t))
+		case "user/6084/monetary-account/9520/mastercard-action":
+			sendResponseWithSignature(t, w, http.StatusOK, getMasterCardActionListing(t))
+		case "user/6084/card", "user/6084/card/77":
+			switch r.Method {
+			case http.MethodGet, http.MethodPut:
+				sendResponseWithSignature(t, w, http.StatusOK, getCardGet(t))
+			default:
+				t.Errorf(errorRequestToUnMockedHTTPMethod, r.URL, r.Method)
+			}
+		case "user/6084/card/77/replace":
+			switch r.Method {
+			case http.MethodPost:
+				sendResponseWithSignature(t, w, http.StatusOK, getGenericIDResponse(t))
+			default:
+				t.Errorf(errorRequestToUnMockedHTTPMethod, r.URL, r.Method)
+			}
+		case "user/6084/monetary-account/10111/payment", "user/7082/monetary-account/10111/payment", "user/6084/monetary-account/10111/payment/1", "user/6084/monetary-account/10111/payment/6292":
+			if r.Method == http.MethodPost {
+				sendResponseWithSignature(t, w, http.StatusOK, getGenericIDResponse(t))
+			} else if r.URL.Query().Get("older_id") != "" {
+				sendResponseWithSignature(t, w, http.StatusOK, getPaymentGetLastPage(t))
+			} else {
+				sendResponseWithSignature(t, w, http.StatusOK, getPaymentGet(t))
+			}
+		case "user/6084/monetary-account/9601/schedule-payment", "user/6084/monetary-account/9601/schedule-payment/1":
+			switch r.Method {
+			case http.MethodGet:
+				sendResponseWithSignature(t, w, http.StatusOK, getScheduledPaymentGet(t))
+			case http.MethodPost, http.MethodPut, http.MethodDelete:
+				sendResponseWithSignature(t, w, http.StatusOK, getGenericIDResponse(t))
+			default:
+				t.Errorf(errorRequestToUnMockedHTTPMethod, r.URL, r.Method)
+			}
+		case "user/6084/monetary-account/9601/schedule-payment-batch", "user/6084/monetary-account/9601/schedule-payment-batch/2":
+			switch r.Method {
+			case http.MethodPost, http.MethodPut, http.MethodDelete:
+				sendResponseWithSignature(t, w, http.StatusOK, getGenericIDResponse(t))
+			default:
+				t.Errorf(errorRequestToUnMockedHTTPMethod, r.URL, r.Method)
+			}
+		case "user/6084/monetary-account/9601/schedule/1/schedule-instance":
+			sendResponseWithSignature(t, w, http.StatusOK, getScheduleInstanceListing(t))
 		case "user/6084/monetary-account/9999/request-response":
 			sendResponseWithSignature(t, w, http.StatusOK, getRequestResponseGet(t))
+		case "user/6084/monetary-account/9999/request-response/7":
+			switch r.Method {
+			case http.MethodPut:
+				sendResponseWithSignature(t, w, http.StatusOK, getGenericIDResponse(t))
+			default:
+				t.Errorf(errorRequestToUnMockedHTTPMethod, r.URL, r.Method)
+			}
+		case "user/6084/monetary-account/9999/request-inquiry", "user/6084/monetary-account/9999/request-inquiry/42":
+			switch r.Method {
+			case http.MethodGet:
+				sendResponseWithSignature(t, w, http.StatusOK, getRequestInquiryGet(t))
+			case http.MethodPost, http.MethodPut:
+				sendResponseWithSignature(t, w, http.StatusOK, getGenericIDResponse(t))
+			default:
+				t.Errorf(errorRequestToUnMockedHTTPMethod, r.URL, r.Method)
+			}
+		case "user/6084/monetary-account/9999/request-inquiry-batch", "user/6084/monetary-account/9999/request-inquiry-batch/43":
+			switch r.Method {
+			case http.MethodGet:
+				sendResponseWithSignature(t, w, http.StatusOK, getRequestInquiryBatchGet(t))
+			case http.MethodPost, http.MethodPut:
+				sendResponseWithSignature(t, w, http.StatusOK, getGenericIDResponse(t))
+			default:
+				t.Errorf(errorRequestToUnMockedHTTPMethod, r.URL, r.Method)
+			}
+		case "user/6084/notification-filter-url", "user/6084/monetary-account/9601/notification-filter-url":
+			switch r.Method {
+			case http.MethodGet, http.MethodPost:
+				sendResponseWithSignature(t, w, http.StatusOK, getNotificationFilterURLGet(t))
+			default:
+				t.Errorf(errorRequestToUnMockedHTTPMethod, r.URL, r.Method)
+			}
 		case "attachment-public/f9a1a89a-fdc1-4de5-89d5-e477cccd22c4/content":
 			sendResponseWithSignature(t, w, http.StatusOK, getPaymentGet(t))
 		case "/v1/session/133912", "v1/session/133912", "session/133912":
@@ -125,11 +203,18 @@ func getSessionServerResponse(t *testing.T) *responseSessionServer {
 	return res.(*responseSessionServer)
 }
 
-func getUserPersonGetResponse(t *testing.T) *responseUserPerson {
-	var obj responseUserPerson
+func getUserCompanyGetResponse(t *testing.T) *ResponseUserCompanyGet {
+	var obj ResponseUserCompanyGet
+	res := createResponseStruct(t, formatFilePathByName("user_company_get_response"), &obj)
+
+	return res.(*ResponseUserCompanyGet)
+}
+
+func getUserPersonGetResponse(t *testing.T) *ResponseUserPersonGet {
+	var obj ResponseUserPersonGet
 	res := createResponseStruct(t, formatFilePathByName("user_person_get_response"), &obj)
 
-	return res.(*responseUserPerson)
+	return res.(*ResponseUserPersonGet)
 }
 
 func getMonetaryAccountBankGet(t *testing.T) *ResponseMonetaryAccountBankGet {
@@ -153,18 +238,32 @@ func getGenericIDResponse(t *testing.T) *responseBunqID {
 	return res.(*responseBunqID)
 }
 
-func getDraftPaymentGet(t *testing.T) *responseDraftPaymentGet {
-	var obj responseDraftPaymentGet
+func getDraftPaymentGet(t *testing.T) *ResponseDraftPaymentGet {
+	var obj ResponseDraftPaymentGet
 	res := createResponseStruct(t, formatFilePathByName("draft_payment_get_response"), &obj)
 
-	return res.(*responseDraftPaymentGet)
+	return res.(*ResponseDraftPaymentGet)
 }
 
-func getMasterCardActionGet(t *testing.T) *responseMasterCardActionGet {
-	var obj responseMasterCardActionGet
+func getMasterCardActionGet(t *testing.T) *ResponseMasterCardActionGet {
+	var obj ResponseMasterCardActionGet
 	res := createResponseStruct(t, formatFilePathByName("master_card_action_get_response"), &obj)
 
-	return res.(*responseMasterCardActionGet)
+	return res.(*ResponseMasterCardActionGet)
+}
+
+func getMasterCardActionListing(t *testing.T) *ResponseMasterCardActionGet {
+	var obj ResponseMasterCardActionGet
+	res := createResponseStruct(t, formatFilePathByName("master_card_action_listing_response"), &obj)
+
+	return res.(*ResponseMasterCardActionGet)
+}
+
+func getCardGet(t *testing.T) *ResponseCardGet {
+	var obj ResponseCardGet
+	res := createResponseStruct(t, formatFilePathByName("card_get_response"), &obj)
+
+	return res.(*ResponseCardGet)
 }
 
 func getPaymentGet(t *testing.T) *ResponsePaymentGet {
@@ -174,6 +273,14 @@ func getPaymentGet(t *testing.T) *ResponsePaymentGet {
 	return res.(*ResponsePaymentGet)
 }
 
+// getPaymentGetLastPage returns the payment response without an older page.
+func getPaymentGetLastPage(t *testing.T) *ResponsePaymentGet {
+	res := getPaymentGet(t)
+	res.Pagination.OlderURL = ""
+
+	return res
+}
+
 func getScheduledPaymentGet(t *testing.T) *ResponseScheduledPaymentsGet {
 	var obj ResponseScheduledPaymentsGet
 	res := createResponseStruct(t, formatFilePathByName("schedule_payment_response"), &obj)
@@ -188,6 +295,48 @@ func getRequestResponseGet(t *testing.T) *ResponseRequestResponsesGet {
 	return res.(*ResponseRequestResponsesGet)
 }
 
+func getRequestInquiryGet(t *testing.T) *ResponseRequestInquiryGet {
+	var obj ResponseRequestInquiryGet
+	res := createResponseStruct(t, formatFilePathByName("request_inquiry_get_response"), &obj)
+
+	return res.(*ResponseRequestInquiryGet)
+}
+
+func getNotificationFilterURLGet(t *testing.T) *ResponseNotificationFilterURLGet {
+	var obj ResponseNotificationFilterURLGet
+	res := createResponseStruct(t, formatFilePathByName("notification_filter_url_response"), &obj)
+
+	return res.(*ResponseNotificationFilterURLGet)
+}
+
+func getRequestInquiryBatchGet(t *testing.T) *ResponseRequestInquiryBatchGet {
+	var obj ResponseRequestInquiryBatchGet
+	res := createResponseStruct(t, formatFilePathByName("request_inquiry_batch_get_response"), &obj)
+
+	return res.(*ResponseRequestInquiryBatchGet)
+}
+
+func getScheduleInstanceListing(t *testing.T) *responseScheduleInstancesGet {
+	var obj responseScheduleInstancesGet
+	res := createResponseStruct(t, formatFilePathByName("schedule_instance_listing_response"), &obj)
+
+	return res.(*responseScheduleInstancesGet)
+}
+
+func getMonetaryAccountJointGet(t *testing.T) *ResponseMonetaryAccountJointGet {
+	var obj ResponseMonetaryAccountJointGet
+	res := createResponseStruct(t, formatFilePathByName("monetary_account_joint_response"), &obj)
+
+	return res.(*ResponseMonetaryAccountJointGet)
+}
+
+func getMonetaryAccountListing(t *testing.T) *ResponseMonetaryAccountGet {
+	var obj ResponseMonetaryAccountGet
+	res := createResponseStruct(t, formatFilePathByName("monetary_account_listing_response"), &obj)
+
+	return res.(*ResponseMonetaryAccountGet)
+}
+
 func getErrorResponse(t *testing.T) *responseError {
 	var obj responseError
 	res := createResponseStruct(t, formatFilePathByName("error_response"), &obj)
@@ -213,6 +362,18 @@ func formatFilePathByName(fileName string) string {
 	return fmt.Sprintf("../testdata/bunq/%s.json", fileName)
 }
 
+// sendResponseWithSignatureForMethod sends body for GET requests and a generic id response for POST and PUT requests.
+func sendResponseWithSignatureForMethod(t *testing.T, w http.ResponseWriter, r *http.Request, body interface{}) {
+	switch r.Method {
+	case http.MethodGet:
+		sendResponseWithSignature(t, w, http.StatusOK, body)
+	case http.MethodPost, http.MethodPut:
+		sendResponseWithSignature(t, w, http.StatusOK, getGenericIDResponse(t))
+	default:
+		t.Errorf(errorRequestToUnMockedHTTPMethod, r.URL, r.Method)
+	}
+}
+
 func sendResponseWithSignature(t *testing.T, w http.ResponseWriter, resCode int, body interface{}) {
 	b, _ := json.Marshal(body)
 	stringToSign := fmt.Sprintf("%s", b)
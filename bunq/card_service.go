@@ -1,24 +1,193 @@
 package bunq
 
 import (
+	"bytes"
+	"context"
+	"encoding/json"
 	"fmt"
 	"net/http"
+
+	"github.com/pkg/errors"
 )
 
 type cardService service
 
-func (c *cardService) GetMasterCardAction(id, monetaryAccountID int) (*responseMasterCardActionGet, error) {
+// cardItemKeys are the keys bunq stores a card under in a listing, depending on the card type.
+var cardItemKeys = []string{"CardDebit", "CardCredit"}
+
+func (c *cardService) GetMasterCardAction(id, monetaryAccountID int) (*ResponseMasterCardActionGet, error) {
+	return c.GetMasterCardActionCtx(context.Background(), id, monetaryAccountID)
+}
+
+// GetMasterCardActionCtx is GetMasterCardAction with a context for the request.
+func (c *cardService) GetMasterCardActionCtx(ctx context.Context, id, monetaryAccountID int) (*ResponseMasterCardActionGet, error) {
+	userID, err := c.client.GetUserID()
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := c.client.preformRequest(ctx, http.MethodGet, c.client.formatRequestURL(fmt.Sprintf(endpointMasterCardActionGet, userID, monetaryAccountID, id)), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var resStruct ResponseMasterCardActionGet
+
+	return &resStruct, c.client.parseResponse(res, &resStruct)
+}
+
+// IterateMasterCardActions returns an iterator over the MasterCard actions of the given account that match the
+// filter, use an empty filter for all actions. bunq does not support filtering these server side, so the pages
+// are fetched and filtered by the iterator.
+func (c *cardService) IterateMasterCardActions(monetaryAccountID int, filter MasterCardActionFilter, opts PageOptions) *MasterCardActionIterator {
+	userID, err := c.client.GetUserID()
+	if err != nil {
+		return &MasterCardActionIterator{it: newPageIteratorFromError(err)}
+	}
+
+	return &MasterCardActionIterator{
+		it:     newPageIterator(c.client, "MasterCardAction", fmt.Sprintf(endpointMasterCardActionListing, userID, monetaryAccountID), opts),
+		filter: filter,
+	}
+}
+
+// IterateCards returns an iterator over the cards of the user.
+func (c *cardService) IterateCards(opts PageOptions) *CardIterator {
+	userID, err := c.client.GetUserID()
+	if err != nil {
+		return &CardIterator{it: newPageIteratorFromError(err)}
+	}
+
+	it := newPageIterator(c.client, cardItemKeys[0], fmt.Sprintf(endpointCardListing, userID), opts)
+	it.itemKeys = cardItemKeys
+
+	return &CardIterator{it: it}
+}
+
+// GetCard returns the card with the given id.
+func (c *cardService) GetCard(cardID int) (*ResponseCardGet, error) {
+	return c.GetCardCtx(context.Background(), cardID)
+}
+
+// GetCardCtx is GetCard with a context for the request.
+func (c *cardService) GetCardCtx(ctx context.Context, cardID int) (*ResponseCardGet, error) {
 	userID, err := c.client.GetUserID()
 	if err != nil {
 		return nil, err
 	}
 
-	res, err := c.client.preformRequest(http.MethodGet, c.client.formatRequestURL(fmt.Sprintf(endpointMasterCardActionGet, userID, monetaryAccountID, id)), nil)
+	res, err := c.client.preformRequest(ctx, http.MethodGet, c.client.formatRequestURL(fmt.Sprintf(endpointCardWithID, userID, cardID)), nil)
 	if err != nil {
 		return nil, err
 	}
 
-	var resStruct responseMasterCardActionGet
+	var resStruct ResponseCardGet
 
 	return &resStruct, c.client.parseResponse(res, &resStruct)
 }
+
+// UpdateCard changes the status, limits or pin code assignments of a card and returns the updated card.
+func (c *cardService) UpdateCard(cardID int, update CardUpdate) (*ResponseCardGet, error) {
+	return c.UpdateCardCtx(context.Background(), cardID, update)
+}
+
+// UpdateCardCtx is UpdateCard with a context for the request.
+func (c *cardService) UpdateCardCtx(ctx context.Context, cardID int, update CardUpdate) (*ResponseCardGet, error) {
+	userID, err := c.client.GetUserID()
+	if err != nil {
+		return nil, err
+	}
+
+	err = update.validate()
+	if err != nil {
+		return nil, err
+	}
+
+	bodyRaw, err := json.Marshal(update)
+	if err != nil {
+		return nil, errors.Wrap(err, "bunq: could not marshal body")
+	}
+
+	res, err := c.client.preformRequest(ctx, http.MethodPut, c.client.formatRequestURL(fmt.Sprintf(endpointCardWithID, userID, cardID)), bytes.NewBuffer(bodyRaw))
+	if err != nil {
+		return nil, err
+	}
+
+	var resStruct ResponseCardGet
+
+	return &resStruct, c.client.parseResponse(res, &resStruct)
+}
+
+// UpdateCardStatus sets the status of a card, e.g. to block a lost card.
+func (c *cardService) UpdateCardStatus(cardID int, status CardStatus) (*ResponseCardGet, error) {
+	return c.UpdateCardStatusCtx(context.Background(), cardID, status)
+}
+
+// UpdateCardStatusCtx is UpdateCardStatus with a context for the request.
+func (c *cardService) UpdateCardStatusCtx(ctx context.Context, cardID int, status CardStatus) (*ResponseCardGet, error) {
+	return c.UpdateCardCtx(ctx, cardID, CardUpdate{Status: &status})
+}
+
+// ReplaceCard orders a replacement for the card.
+func (c *cardService) ReplaceCard(cardID int, replace CardReplace) (*responseBunqID, error) {
+	return c.ReplaceCardCtx(context.Background(), cardID, replace)
+}
+
+// ReplaceCardCtx is ReplaceCard with a context for the request.
+func (c *cardService) ReplaceCardCtx(ctx context.Context, cardID int, replace CardReplace) (*responseBunqID, error) {
+	userID, err := c.client.GetUserID()
+	if err != nil {
+		return nil, err
+	}
+
+	bodyRaw, err := json.Marshal(replace)
+	if err != nil {
+		return nil, errors.Wrap(err, "bunq: could not marshal body")
+	}
+
+	return c.client.doCURequest(ctx, c.client.formatRequestURL(fmt.Sprintf(endpointCardReplace, userID, cardID)), bodyRaw, http.MethodPost)
+}
+
+// MasterCardActionFilter selects MasterCard actions by their decision, authorisation status and
+// settlement status. An empty field matches every action, multiple values of a field match any of them.
+type MasterCardActionFilter struct {
+	Decision            []MasterCardActionDecision
+	AuthorisationStatus []MasterCardActionAuthorisationStatus
+	SettlementStatus    []MasterCardActionSettlementStatus
+}
+
+func (f MasterCardActionFilter) matches(a *MasterCardAction) bool {
+	return f.matchesDecision(a.Decision) &&
+		f.matchesAuthorisationStatus(a.AuthorisationStatus) &&
+		f.matchesSettlementStatus(a.SettlementStatus)
+}
+
+func (f MasterCardActionFilter) matchesDecision(v MasterCardActionDecision) bool {
+	for _, d := range f.Decision {
+		if d == v {
+			return true
+		}
+	}
+
+	return len(f.Decision) == 0
+}
+
+func (f MasterCardActionFilter) matchesAuthorisationStatus(v MasterCardActionAuthorisationStatus) bool {
+	for _, st := range f.AuthorisationStatus {
+		if st == v {
+			return true
+		}
+	}
+
+	return len(f.AuthorisationStatus) == 0
+}
+
+func (f MasterCardActionFilter) matchesSettlementStatus(v MasterCardActionSettlementStatus) bool {
+	for _, st := range f.SettlementStatus {
+		if st == v {
+			return true
+		}
+	}
+
+	return len(f.SettlementStatus) == 0
+}
@@ -1,8 +1,10 @@
 package bunq
 
 import (
-	"github.com/stretchr/testify/assert"
+	"context"
 	"testing"
+
+	"github.com/stretchr/testify/assert"
 )
 
 func TestCardService_GetMasterCardAction(t *testing.T) {
@@ -19,3 +21,161 @@ func TestCardService_GetMasterCardAction(t *testing.T) {
 	assert.NoError(t, err)
 	assert.NotZero(t, res.Response[0].MasterCardAction.ID)
 }
+
+func TestCardService_IterateMasterCardActions(t *testing.T) {
+	t.Parallel()
+
+	c, fakeServer, cancel := createClientWithFakeServer(t)
+	defer cancel()
+	defer fakeServer.Close()
+
+	assert.NoError(t, c.Init())
+
+	it := c.CardService.IterateMasterCardActions(9520, MasterCardActionFilter{}, PageOptions{})
+
+	var ids []int
+	for it.Next(context.Background()) {
+		ids = append(ids, it.Item().ID)
+	}
+
+	assert.NoError(t, it.Err())
+	assert.Equal(t, []int{327, 326, 325}, ids)
+}
+
+func TestCardService_IterateCards(t *testing.T) {
+	t.Parallel()
+
+	c, fakeServer, cancel := createClientWithFakeServer(t)
+	defer cancel()
+	defer fakeServer.Close()
+
+	assert.NoError(t, c.Init())
+
+	it := c.CardService.IterateCards(PageOptions{})
+
+	var cards []Card
+	for it.Next(context.Background()) {
+		cards = append(cards, it.Item())
+	}
+
+	if assert.NoError(t, it.Err()) && assert.Len(t, cards, 2) {
+		assert.Equal(t, 77, cards[0].ID)
+		assert.Equal(t, CardStatusActive, cards[0].Status)
+		assert.Equal(t, 9601, cards[0].PinCodeAssignment[0].MonetaryAccountID)
+		assert.Equal(t, 78, cards[1].ID)
+		assert.Equal(t, CardStatusDeactivated, cards[1].Status)
+	}
+}
+
+func TestCardService_GetCard(t *testing.T) {
+	t.Parallel()
+
+	c, fakeServer, cancel := createClientWithFakeServer(t)
+	defer cancel()
+	defer fakeServer.Close()
+
+	assert.NoError(t, c.Init())
+
+	res, err := c.CardService.GetCard(77)
+
+	if assert.NoError(t, err) {
+		assert.Equal(t, "500.00", res.Cards()[0].CardLimit.Value)
+	}
+}
+
+func TestCardService_UpdateCard(t *testing.T) {
+	t.Parallel()
+
+	c, fakeServer, cancel := createClientWithFakeServer(t)
+	defer cancel()
+	defer fakeServer.Close()
+
+	assert.NoError(t, c.Init())
+
+	res, err := c.CardService.UpdateCard(77, CardUpdate{
+		CardLimit: &Amount{Value: "500.00", Currency: "EUR"},
+		PinCodeAssignment: []CardPinCodeAssignment{
+			{Type: CardPinAssignmentPrimary, MonetaryAccountID: 9601},
+		},
+	})
+
+	assert.NoError(t, err)
+	assert.NotEmpty(t, res.Cards())
+
+	_, err = c.CardService.UpdateCardStatus(77, CardStatusLost)
+	assert.NoError(t, err)
+
+	_, err = c.CardService.UpdateCard(77, CardUpdate{CardLimitAtm: &Amount{Value: "500", Currency: "EUR"}})
+	assert.Error(t, err)
+}
+
+func TestCardService_ReplaceCard(t *testing.T) {
+	t.Parallel()
+
+	c, fakeServer, cancel := createClientWithFakeServer(t)
+	defer cancel()
+	defer fakeServer.Close()
+
+	assert.NoError(t, c.Init())
+
+	res, err := c.CardService.ReplaceCard(77, CardReplace{NameOnCard: "K. Ogkevin"})
+
+	assert.NoError(t, err)
+	assert.NotZero(t, res.Response[0].ID.ID)
+}
+
+func TestCardService_IterateMasterCardActionsFilter(t *testing.T) {
+	t.Parallel()
+
+	c, fakeServer, cancel := createClientWithFakeServer(t)
+	defer cancel()
+	defer fakeServer.Close()
+
+	assert.NoError(t, c.Init())
+
+	tests := []struct {
+		name   string
+		filter MasterCardActionFilter
+		ids    []int
+	}{
+		{name: "no filter", ids: []int{327, 326, 325}},
+		{name: "decision", filter: MasterCardActionFilter{Decision: []MasterCardActionDecision{MasterCardActionDecisionAllowed}}, ids: []int{327, 325}},
+		{
+			name: "authorisation and settlement status",
+			filter: MasterCardActionFilter{
+				AuthorisationStatus: []MasterCardActionAuthorisationStatus{MasterCardActionAuthorisationStatusAuthorised},
+				SettlementStatus:    []MasterCardActionSettlementStatus{MasterCardActionSettlementStatusUnsettled},
+			},
+			ids: []int{325},
+		},
+		{
+			name: "any of the values",
+			filter: MasterCardActionFilter{
+				SettlementStatus: []MasterCardActionSettlementStatus{MasterCardActionSettlementStatusSettled, MasterCardActionSettlementStatusUnsettled},
+			},
+			ids: []int{327, 326, 325},
+		},
+	}
+
+	for _, tt := range tests {
+		it := c.CardService.IterateMasterCardActions(9520, tt.filter, PageOptions{})
+
+		var ids []int
+		for it.Next(context.Background()) {
+			ids = append(ids, it.Item().ID)
+		}
+
+		assert.NoError(t, it.Err(), tt.name)
+		assert.Equal(t, tt.ids, ids, tt.name)
+	}
+
+	it := c.CardService.IterateMasterCardActions(9520, MasterCardActionFilter{Decision: []MasterCardActionDecision{MasterCardActionDecisionAllowed}}, PageOptions{Count: 10})
+
+	var ids []int
+	for it.Next(context.Background()) {
+		ids = append(ids, it.Item().ID)
+	}
+
+	assert.NoError(t, it.Err())
+	assert.Equal(t, []int{327, 325}, ids)
+}
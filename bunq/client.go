@@ -29,6 +29,9 @@ const (
 	headerXBunqRequestID   string = "X-Bunq-Client-Request-Id"
 	headerXBunqGeoLocation string = "X-Bunq-Geolocation"
 
+	closeOnCancelTimeout time.Duration = time.Second * 30
+	sessionDeleteTimeout time.Duration = time.Second * 10
+
 	// BaseURLSandbox The base URL for the sanbox API.
 	BaseURLSandbox string = "https://public-api.sandbox.bunq.com/v1/"
 	// BaseURLProduction The base URL for the prod api
@@ -67,11 +70,29 @@ type Client struct {
 	Debug       bool
 	description string
 
+	// Deprecated: Err is no longer set, use Health or SetSessionEvents instead.
 	Err error
 
-	requestQueue             chan queueEntry
-	requestRateLimitMapMutex sync.RWMutex
-	requestRateLimitMap      map[string]time.Time
+	session sessionState
+
+	retryPolicy  RetryPolicy
+	contextStore ContextStore
+
+	requestQueue chan queueEntry
+	rateLimiter  RateLimiter
+
+	// workerCtx is cancelled by Close, it stops the request and session worker. It is not derived from ctx,
+	// cancelling ctx makes the client close instead.
+	workerCtx   context.Context
+	stopWorkers context.CancelFunc
+	workers     sync.WaitGroup
+	closeMu     sync.RWMutex
+	closing     bool
+	stopped     bool
+	inFlight    sync.WaitGroup
+	closeOnce   sync.Once
+	closeErr    error
+	closed      chan struct{}
 
 	privateKey      *rsa.PrivateKey
 	serverPublicKey *rsa.PublicKey
@@ -88,17 +109,19 @@ type Client struct {
 	installationContext  *installation
 	sessionServerContext *sessionServer
 
-	common                  service
-	installation            *installationService
-	deviceServer            *deviceServerService
-	sessionServer           *sessionServerService
-	UserService             *userService
-	AccountService          *accountService
-	PaymentService          *paymentService
-	ScheduledPaymentService *scheduledPaymentService
-	CardService             *cardService
-	ContentService          *contentService
-	RequestResponseService  *requestResponseService
+	common                    service
+	installation              *installationService
+	deviceServer              *deviceServerService
+	sessionServer             *sessionServerService
+	UserService               *userService
+	AccountService            *accountService
+	PaymentService            *paymentService
+	ScheduledPaymentService   *scheduledPaymentService
+	CardService               *cardService
+	ContentService            *contentService
+	RequestResponseService    *requestResponseService
+	RequestInquiryService     *requestInquiryService
+	NotificationFilterService *notificationFilterService
 }
 
 // NewClientFromContext create a new bunq client from a saved client context.
@@ -124,6 +147,14 @@ func NewClientFromContext(ctx context.Context, clientCtx *ClientContext) (*Clien
 
 	c.installationContext = clientCtx.InstallationContext
 	c.sessionServerContext = clientCtx.SessionServerContext
+
+	if c.sessionServerContext == nil {
+		// The context was saved before a session was created.
+		c.token = &c.installationContext.Token.Token
+
+		return c, nil
+	}
+
 	c.token = &c.sessionServerContext.Token.Token
 
 	c.updateUserFlag()
@@ -161,7 +192,10 @@ func NewEmptyClient(ctx context.Context) *Client {
 
 func (c *Client) registerServices() {
 	c.requestQueue = make(chan queueEntry, 9)
-	c.requestRateLimitMap = make(map[string]time.Time)
+	c.workerCtx, c.stopWorkers = context.WithCancel(context.Background())
+	c.closed = make(chan struct{})
+	c.rateLimiter = NewTokenBucketRateLimiter()
+	c.retryPolicy = DefaultRetryPolicy
 
 	c.common.client = c
 
@@ -175,8 +209,11 @@ func (c *Client) registerServices() {
 	c.CardService = (*cardService)(&c.common)
 	c.ContentService = (*contentService)(&c.common)
 	c.RequestResponseService = (*requestResponseService)(&c.common)
+	c.RequestInquiryService = (*requestInquiryService)(&c.common)
+	c.NotificationFilterService = (*notificationFilterService)(&c.common)
 
 	c.spawnRequestHandlerWorker()
+	c.spawnCloseOnCancelWatcher()
 }
 
 // SetAPIKey sets the api key
@@ -189,108 +226,176 @@ func (c *Client) SetPrivateKey(key *rsa.PrivateKey) {
 	c.privateKey = key
 }
 
-// spawnRequestHandlerWorker will spawn a request queue worker that ensuers that all request
-// that this client is making will be within the 1 request per second
-// rate limit that bunq has.
+// SetRateLimiter sets the rate limiter that decides when queued requests are sent.
+func (c *Client) SetRateLimiter(l RateLimiter) {
+	c.rateLimiter = l
+}
+
+// spawnRequestHandlerWorker will spawn a request queue worker that ensures that all requests
+// that this client is making stay within the rate limits that bunq has. Every entry is handed
+// to its own goroutine that waits for the rate limiter, so a full bucket for one endpoint
+// does not hold back requests to other endpoints.
 //
-// It starts when a new client has been created and dies when the client dies.
+// It starts when a new client has been created and dies when the client closes. Entries that are
+// still queued at that moment fail with ErrClientClosed.
 func (c *Client) spawnRequestHandlerWorker() {
+	c.workers.Add(1)
+
 	go func() {
+		defer c.workers.Done()
+
 		for {
 			select {
-			case <-c.ctx.Done():
-				close(c.requestQueue)
+			case <-c.workerCtx.Done():
+				c.failQueuedRequests()
 				return
-			default:
-				entry := <-c.requestQueue
+			case entry := <-c.requestQueue:
+				c.workers.Add(1)
 
-				lastRequest := c.getLastExecutionTimeForRequest(entry.req)
-				diff := time.Now().UTC().Sub(lastRequest)
+				go func() {
+					defer c.workers.Done()
+					c.handleQueueEntry(entry)
+				}()
+			}
+		}
+	}()
+}
 
-				if diff.Seconds() < 1.0 {
-					if c.Debug {
-						log.Printf("bunq: waiting %f seconds before sending the http request.", 1.0-diff.Seconds())
-					}
+func (c *Client) failQueuedRequests() {
+	for {
+		select {
+		case entry := <-c.requestQueue:
+			entry.resChan <- nil
+			entry.errChan <- ErrClientClosed
+		default:
+			return
+		}
+	}
+}
 
-					time.Sleep(time.Duration((1.0 - diff.Seconds()) * float64(time.Second)))
-				}
+// handleQueueEntry sends the request of entry. The request is bound to the worker context as well, so
+// stopping the workers fails requests that are waiting for the rate limiter or still in flight with
+// ErrClientClosed instead of sending them after the client closed.
+func (c *Client) handleQueueEntry(entry queueEntry) {
+	start := time.Now()
 
-				go c.registerRequestInRateLimitMap(entry.req)
+	ctx, cancel := context.WithCancel(entry.req.Context())
+	defer cancel()
 
-				if c.Debug {
-					dump, _ := httputil.DumpRequest(entry.req, true)
-					log.Printf("\n%s\n", dump)
-				}
+	go func() {
+		select {
+		case <-c.workerCtx.Done():
+			cancel()
+		case <-ctx.Done():
+		}
+	}()
 
-				res, err := c.Do(entry.req)
+	req := entry.req.WithContext(ctx)
 
-				if err != nil && c.Debug {
-					log.Print(err)
-				}
+	err := c.rateLimiter.Wait(req)
+	if c.workerCtx.Err() != nil {
+		err = ErrClientClosed
+	}
 
-				if c.Debug && err == nil {
-					dump, _ := httputil.DumpResponse(res, true)
-					log.Printf("\n%s\n", dump)
-				}
+	if err != nil {
+		entry.resChan <- nil
+		entry.errChan <- errors.Wrap(err, "bunq: waiting for rate limiter failed")
+		return
+	}
 
-				entry.resChan <- res
-				entry.errChan <- errors.Wrap(err, "bunq: http request failed.")
-			}
+	if c.Debug {
+		log.Printf("bunq: rate limiter delayed the http request for %f seconds.", time.Since(start).Seconds())
+		dump, _ := httputil.DumpRequest(req, true)
+		log.Printf("\n%s\n", dump)
+	}
+
+	res, err := c.Do(req)
+	if err == nil {
+		// The body is read here, cancelling ctx on return would otherwise abort reading it.
+		err = bufferResponseBody(res)
+	}
+
+	if err != nil {
+		res = nil
+
+		if c.workerCtx.Err() != nil {
+			err = ErrClientClosed
 		}
-	}()
-}
+	}
 
-func (c *Client) getLastExecutionTimeForRequest(r *http.Request) time.Time {
-	c.requestRateLimitMapMutex.RLock()
-	defer c.requestRateLimitMapMutex.RUnlock()
+	if err != nil && c.Debug {
+		log.Print(err)
+	}
 
-	if t, ok := c.requestRateLimitMap[r.URL.Path]; ok {
-		return t
+	if c.Debug && err == nil {
+		dump, _ := httputil.DumpResponse(res, true)
+		log.Printf("\n%s\n", dump)
 	}
 
-	return time.Now().UTC().Add(time.Duration(-2) * time.Second)
+	entry.resChan <- res
+	entry.errChan <- errors.Wrap(err, "bunq: http request failed.")
 }
 
-func (c *Client) registerRequestInRateLimitMap(r *http.Request) {
-	c.requestRateLimitMapMutex.Lock()
-	defer c.requestRateLimitMapMutex.Unlock()
+func bufferResponseBody(res *http.Response) error {
+	defer res.Body.Close()
+
+	body, err := ioutil.ReadAll(res.Body)
+	if err != nil {
+		return errors.Wrap(err, "bunq: could not read response body")
+	}
+
+	res.Body = ioutil.NopCloser(bytes.NewBuffer(body))
 
-	c.requestRateLimitMap[r.URL.Path] = time.Now().UTC()
+	return nil
 }
 
 func (c *Client) do(r *http.Request) (*http.Response, error) {
+	c.closeMu.RLock()
+	if c.closing {
+		c.closeMu.RUnlock()
+		return nil, ErrClientClosed
+	}
+	c.inFlight.Add(1)
+	c.closeMu.RUnlock()
+
+	defer c.inFlight.Done()
+
 	err := c.setAllNeededHeader(r)
 	if err != nil {
 		return nil, errors.Wrap(err, "bunq: could not set all required headers")
 	}
 
-	resChan := make(chan *http.Response, 1)
-	errChan := make(chan error, 1)
+	var res *http.Response
 
-	c.requestQueue <- queueEntry{
-		req:     r,
-		resChan: resChan,
-		errChan: errChan,
-	}
+	for attempt := 1; ; attempt++ {
+		res, err = c.enqueueRequest(r)
+		if err != nil {
+			return nil, err
+		}
 
-	res, err := <-resChan, <-errChan
-	if err != nil {
-		return nil, err
-	}
+		if res.StatusCode == http.StatusOK {
+			break
+		}
 
-	if res.StatusCode != http.StatusOK {
-		if res.StatusCode == http.StatusInternalServerError {
-			return nil, errors.New(fmt.Sprintf("bunq: http request failed with status %d", res.StatusCode))
+		if !c.retryPolicy.shouldRetry(r, res, attempt) {
+			return nil, newAPIError(r, res)
 		}
 
-		errResponse := createErrorResponse(res)
+		wait := c.retryPolicy.delay(res, attempt)
 
-		return nil, fmt.Errorf(
-			"bunq: http request failed with status %d and description %q and response header: %q",
-			res.StatusCode,
-			errResponse.Error[0].ErrorDescription,
-			res.Header.Get("X-Bunq-Client-Response-Id"),
-		)
+		if c.Debug {
+			log.Printf("bunq: request failed with status %d, retrying in %f seconds.", res.StatusCode, wait.Seconds())
+		}
+
+		err = c.prepareRetry(r, res)
+		if err != nil {
+			return nil, err
+		}
+
+		err = sleepCtx(r.Context(), wait)
+		if err != nil {
+			return nil, errors.Wrap(err, "bunq: waiting for retry failed")
+		}
 	}
 
 	err = c.verifyResponse(r, res)
@@ -301,19 +406,69 @@ func (c *Client) do(r *http.Request) (*http.Response, error) {
 	return res, err
 }
 
+// enqueueRequest hands the request to the request worker and waits for the response. Both the wait for
+// a free spot in the queue and the wait for the response stop when the context of the request is done.
+func (c *Client) enqueueRequest(r *http.Request) (*http.Response, error) {
+	ctx := r.Context()
+	resChan := make(chan *http.Response, 1)
+	errChan := make(chan error, 1)
+
+	// The read lock makes sure nothing is queued after the worker has been told to stop,
+	// so every queued entry is either handled or failed by the worker.
+	c.closeMu.RLock()
+	if c.stopped {
+		c.closeMu.RUnlock()
+		return nil, ErrClientClosed
+	}
+
+	select {
+	case c.requestQueue <- queueEntry{
+		req:     r,
+		resChan: resChan,
+		errChan: errChan,
+	}:
+		c.closeMu.RUnlock()
+	case <-ctx.Done():
+		c.closeMu.RUnlock()
+		return nil, errors.Wrap(ctx.Err(), "bunq: waiting for request queue failed")
+	}
+
+	select {
+	case res := <-resChan:
+		return res, <-errChan
+	case <-ctx.Done():
+		return nil, errors.Wrap(ctx.Err(), "bunq: waiting for response failed")
+	}
+}
+
+func sleepCtx(ctx context.Context, d time.Duration) error {
+	t := time.NewTimer(d)
+	defer t.Stop()
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-t.C:
+		return nil
+	}
+}
+
 func (c *Client) setAllNeededHeader(r *http.Request) error {
 	c.setAllDefaultHeader(r)
-	var err error
 
-	if shouldSignOrVerify(r.URL.Path) {
-		c.tokenMutex.RLock()
-		r.Header.Set("X-Bunq-Client-Authentication", *c.token)
-		c.tokenMutex.RUnlock()
+	return c.setAuthHeader(r)
+}
 
-		err = c.addSignatureHeader(r)
+func (c *Client) setAuthHeader(r *http.Request) error {
+	if !shouldSignOrVerify(r.URL.Path) {
+		return nil
 	}
 
-	return err
+	c.tokenMutex.RLock()
+	r.Header.Set("X-Bunq-Client-Authentication", *c.token)
+	c.tokenMutex.RUnlock()
+
+	return c.addSignatureHeader(r)
 }
 
 func shouldSignOrVerify(url string) bool {
@@ -368,22 +523,26 @@ func generateRequestID() string {
 
 // ExportClientContext exports the client context of the current client.
 func (c *Client) ExportClientContext() (ClientContext, error) {
-	p := x509.MarshalPKCS1PrivateKey(c.privateKey)
-	userID, err := c.GetUserID()
+	_, err := c.GetUserID()
 	if err != nil {
 		return ClientContext{}, err
 	}
 
-	ctx := ClientContext{
-		PrivateKey:           p,
+	return c.clientContext(), nil
+}
+
+// clientContext returns the client context, also when there is no session yet.
+func (c *Client) clientContext() ClientContext {
+	userID, _ := c.GetUserID()
+
+	return ClientContext{
+		PrivateKey:           x509.MarshalPKCS1PrivateKey(c.privateKey),
 		InstallationContext:  c.installationContext,
 		SessionServerContext: c.sessionServerContext,
 		APIKey:               c.apiKey,
 		BaseURL:              c.baseURL,
 		UserID:               uint(userID),
 	}
-
-	return ctx, nil
 }
 
 // Init init's the client by preforming installation, device and session server where needed.
@@ -408,6 +567,12 @@ func (c *Client) Init() error {
 				errChan <- errors.Wrap(err, "bunq: could not create new session")
 				return
 			}
+
+			err = c.saveContext()
+			if err != nil {
+				errChan <- err
+				return
+			}
 		}
 
 		c.spawnSessionHandlingWorker()
@@ -420,6 +585,86 @@ func (c *Client) Init() error {
 	return nil
 }
 
+// Close closes the client. It stops accepting new requests, waits for the requests that are in flight,
+// deletes the session at bunq and waits for the workers of the client to exit. When ctx is done before
+// all requests finished, the requests that are still queued fail with ErrClientClosed.
+//
+// Cancelling the context the client was created with closes the client as well. Close can be called
+// multiple times, every call returns the result of the first one.
+func (c *Client) Close(ctx context.Context) error {
+	c.closeOnce.Do(func() {
+		c.closeErr = c.close(ctx)
+		close(c.closed)
+	})
+
+	return c.closeErr
+}
+
+func (c *Client) close(ctx context.Context) error {
+	c.closeMu.Lock()
+	c.closing = true
+	c.closeMu.Unlock()
+
+	drained := make(chan struct{})
+	go func() {
+		c.inFlight.Wait()
+		close(drained)
+	}()
+
+	var drainErr error
+
+	select {
+	case <-drained:
+	case <-ctx.Done():
+		drainErr = errors.Wrap(ctx.Err(), "bunq: not all requests finished before the client closed")
+	}
+
+	c.closeMu.Lock()
+	c.stopped = true
+	c.closeMu.Unlock()
+
+	c.stopWorkers()
+	c.workers.Wait()
+
+	if c.sessionServerContext == nil {
+		return drainErr
+	}
+
+	// ctx may already be done when draining timed out, the session is deleted regardless.
+	deleteCtx, cancel := context.WithTimeout(context.Background(), sessionDeleteTimeout)
+	defer cancel()
+
+	err := c.sessionServer.delete(deleteCtx)
+	if err != nil {
+		err = errors.Wrap(err, "bunq: could not delete session")
+	}
+
+	c.sessionClosed(err)
+
+	switch {
+	case drainErr != nil && err != nil:
+		return errors.Wrap(drainErr, err.Error())
+	case drainErr != nil:
+		return drainErr
+	}
+
+	return err
+}
+
+// spawnCloseOnCancelWatcher closes the client when the context it was created with is cancelled.
+func (c *Client) spawnCloseOnCancelWatcher() {
+	go func() {
+		select {
+		case <-c.ctx.Done():
+			ctx, cancel := context.WithTimeout(context.Background(), closeOnCancelTimeout)
+			defer cancel()
+
+			_ = c.Close(ctx)
+		case <-c.closed:
+		}
+	}()
+}
+
 func (c *Client) preformNewInstallation(errChan chan error) {
 	if c.Debug {
 		log.Print("bunq: installation context is nil, doing installation, device-server and session-server calls")
@@ -437,11 +682,25 @@ func (c *Client) preformNewInstallation(errChan chan error) {
 		return
 	}
 
+	// The context is saved only once the device is registered. A restored context only gets a new session,
+	// so a context saved in between would leave a client that can never register its device.
+	err = c.saveContext()
+	if err != nil {
+		errChan <- err
+		return
+	}
+
 	_, err = c.sessionServer.create()
 	if err != nil {
 		errChan <- errors.Wrap(err, "bunq: could not init session server")
 		return
 	}
+
+	err = c.saveContext()
+	if err != nil {
+		errChan <- err
+		return
+	}
 }
 
 // IsUserPerson returns true if the current auth user is of type UserPerson
@@ -471,48 +730,80 @@ func (c *Client) updateUserFlag() {
 
 // spawnSessionHandlingWorker makes sure that the user session is always valid. This is to ensure that no 403
 // errors happen. The session is valid based on the user's auto logout time in the bunq app.
+// Failures are reported through the SessionEvents and Health, and retried with an exponential backoff.
 func (c *Client) spawnSessionHandlingWorker() {
+	c.workers.Add(1)
+
 	go func() {
+		defer c.workers.Done()
+
 		if c.Debug {
 			log.Print("bunq: spawned session handling worker")
 		}
 
+		backoff := sessionRetryMinBackoff
+
+		retry := func(err error) bool {
+			c.sessionError(err)
+
+			if c.Debug {
+				log.Printf("bunq: session worker will retry in %f seconds: %s", backoff.Seconds(), err)
+			}
+
+			if sleepCtx(c.workerCtx, backoff) != nil {
+				return false
+			}
+
+			backoff = nextSessionBackoff(backoff)
+
+			return true
+		}
+
 		for {
-			select {
-			case <-c.ctx.Done():
-				err := c.sessionServer.delete()
-				if err != nil {
-					c.Err = errors.Wrap(err, "bunq: session handler: could not delete session")
-				}
-				return
-			default:
-				expSec, err := c.getSessionExpInSec()
-				if err != nil {
-					c.Err = errors.Wrap(err, "bunq: could not get exp time")
-					continue
+			expSec, err := c.getSessionExpInSec()
+			if err != nil {
+				if !retry(errors.Wrap(err, "bunq: could not get exp time")) {
+					break
 				}
 
-				expTime := time.Now().UTC().Add(time.Second * time.Duration(expSec-5))
+				continue
+			}
 
-				if c.Debug {
-					log.Printf("bunq: session will expirte at %q", expTime)
-				}
+			c.sessionExpiresAt(time.Now().UTC().Add(time.Second * time.Duration(expSec)))
+			expTime := time.Now().UTC().Add(time.Second * time.Duration(expSec-5))
 
-				if expTime.After(time.Now().UTC()) {
-					timeToSleep := expTime.Sub(time.Now().UTC())
+			if c.Debug {
+				log.Printf("bunq: session will expirte at %q", expTime)
+			}
 
-					if c.Debug {
-						log.Printf("bunq: session worker will sleep for %f seconds until it renews the session.", timeToSleep.Seconds())
-					}
+			timeToSleep := expTime.Sub(time.Now().UTC())
 
-					time.Sleep(timeToSleep)
-				}
+			if c.Debug {
+				log.Printf("bunq: session worker will sleep for %f seconds until it renews the session.", timeToSleep.Seconds())
+			}
 
-				c.setInstallationToken()
-				_, err = c.sessionServer.create()
-				if err != nil {
-					c.Err = errors.Wrap(err, "bunq: session handler: could not create session")
+			if sleepCtx(c.workerCtx, timeToSleep) != nil {
+				break
+			}
+
+			c.setInstallationToken()
+			_, err = c.sessionServer.create()
+			if err != nil {
+				if !retry(errors.Wrap(err, "bunq: session handler: could not create session")) {
+					break
 				}
+
+				continue
+			}
+
+			backoff = sessionRetryMinBackoff
+
+			expSec, _ = c.getSessionExpInSec()
+			c.sessionRenewed(time.Now().UTC().Add(time.Second * time.Duration(expSec)))
+
+			err = c.saveContext()
+			if err != nil {
+				c.sessionError(errors.Wrap(err, "bunq: session handler"))
 			}
 		}
 	}()
@@ -523,6 +814,12 @@ func (c *Client) getSessionExpInSec() (int64, error) {
 		return c.sessionServerContext.UserPerson.SessionTimeout, nil
 	} else if c.IsUserCompany() {
 		return c.sessionServerContext.UserCompany.SessionTimeout, nil
+	} else if c.IsUserAPIKey() {
+		// An API key user, e.g. from OAuth, has no session timeout of its own. Its session is valid as long as
+		// the session of the user that granted it.
+		if timeout := c.sessionServerContext.UserAPIKey.GetSessionTimeout(); timeout != 0 {
+			return timeout, nil
+		}
 	}
 
 	return 0, fmt.Errorf("bunq: could not get user expirty time")
@@ -548,8 +845,8 @@ func (c *Client) GetUserID() (int, error) {
 	return 0, fmt.Errorf("bunq: could not determine user id")
 }
 
-func (c *Client) preformRequest(method, url string, body io.Reader) (*http.Response, error) {
-	r, err := http.NewRequest(method, url, body)
+func (c *Client) preformRequest(ctx context.Context, method, url string, body io.Reader) (*http.Response, error) {
+	r, err := http.NewRequestWithContext(ctx, method, url, body)
 	if err != nil {
 		return nil, errors.Wrap(err, fmt.Sprintf("bunq: could not create request for  %s", url))
 	}
@@ -573,8 +870,31 @@ func (c *Client) parseResponse(res *http.Response, obj interface{}) error {
 	return nil
 }
 
-func (c *Client) doCURequest(url string, bodyRaw []byte, httpMethod string) (*responseBunqID, error) {
-	res, err := c.preformRequest(httpMethod, url, bytes.NewBuffer(bodyRaw))
+// doUserCURequest marshals body, if any, and sends it to endpoint formatted with the user id followed by ids.
+func (c *Client) doUserCURequest(ctx context.Context, method, endpoint string, body interface{}, ids ...int) (*responseBunqID, error) {
+	userID, err := c.GetUserID()
+	if err != nil {
+		return nil, err
+	}
+
+	var bodyRaw []byte
+	if body != nil {
+		bodyRaw, err = json.Marshal(body)
+		if err != nil {
+			return nil, errors.Wrap(err, "bunq: could not marshal body")
+		}
+	}
+
+	args := []interface{}{userID}
+	for _, id := range ids {
+		args = append(args, id)
+	}
+
+	return c.doCURequest(ctx, c.formatRequestURL(fmt.Sprintf(endpoint, args...)), bodyRaw, method)
+}
+
+func (c *Client) doCURequest(ctx context.Context, url string, bodyRaw []byte, httpMethod string) (*responseBunqID, error) {
+	res, err := c.preformRequest(ctx, httpMethod, url, bytes.NewBuffer(bodyRaw))
 	if err != nil {
 		return nil, err
 	}
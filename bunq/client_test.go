@@ -4,9 +4,14 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"github.com/pkg/errors"
 	"github.com/stretchr/testify/assert"
+	"net/http"
+	"net/http/httptest"
 	"os"
+	"sync/atomic"
 	"testing"
+	"time"
 )
 
 func TestClientContextExportAndImport(t *testing.T) {
@@ -85,3 +90,120 @@ func TestSessionRenewal(t *testing.T) {
 	assert.NotEqual(t, token, c.token)
 	assert.Equal(t, *c.token, c.sessionServerContext.Token.Token)
 }
+
+func TestRequestWithCanceledContext(t *testing.T) {
+	t.Parallel()
+
+	c, fakeServer, cancel := createClientWithFakeServer(t)
+	defer cancel()
+	defer fakeServer.Close()
+
+	assert.NoError(t, c.Init())
+
+	ctx, cancl := context.WithCancel(context.Background())
+	cancl()
+
+	_, err := c.PaymentService.GetPaymentCtx(ctx, 10111, 1)
+
+	assert.True(t, errors.Is(err, context.Canceled))
+}
+
+func TestClientClose(t *testing.T) {
+	t.Parallel()
+
+	c, fakeServer, cancel := createClientWithFakeServer(t)
+	defer cancel()
+	defer fakeServer.Close()
+
+	assert.NoError(t, c.Init())
+
+	done := make(chan error, 1)
+	go func() {
+		_, err := c.PaymentService.GetPayment(10111, 1)
+		done <- err
+	}()
+
+	ctx, cancl := context.WithTimeout(context.Background(), time.Second*10)
+	defer cancl()
+
+	assert.NoError(t, c.Close(ctx))
+	assert.True(t, c.Health().Closed)
+
+	err := <-done
+	assert.True(t, err == nil || errors.Cause(err) == ErrClientClosed, "unexpected error: %v", err)
+
+	_, err = c.PaymentService.GetPayment(10111, 1)
+	assert.Equal(t, ErrClientClosed, errors.Cause(err))
+
+	assert.NoError(t, c.Close(ctx))
+}
+
+func TestClientCloseAfterDrainTimeout(t *testing.T) {
+	t.Parallel()
+
+	var deleted int32
+	inFlight := make(chan struct{}, 1)
+
+	fakeHandler := createBunqFakeHandler(t)
+	fakeServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.URL.Path == "/v1/user/6084/monetary-account/10111/payment/1":
+			// Never respond, the request only ends when the client aborts it.
+			inFlight <- struct{}{}
+			<-r.Context().Done()
+			return
+		case r.Method == http.MethodDelete:
+			atomic.AddInt32(&deleted, 1)
+		}
+
+		fakeHandler(w, r)
+	}))
+	defer fakeServer.Close()
+
+	key, err := CreateNewKeyPair()
+	assert.NoError(t, err)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	c := NewClient(ctx, fmt.Sprintf("%s/v1/", fakeServer.URL), key, "", "")
+
+	assert.NoError(t, c.Init())
+
+	done := make(chan error, 1)
+	go func() {
+		_, err := c.PaymentService.GetPayment(10111, 1)
+		done <- err
+	}()
+
+	<-inFlight
+
+	closeCtx, cancl := context.WithTimeout(context.Background(), time.Millisecond*100)
+	defer cancl()
+
+	err = c.Close(closeCtx)
+	assert.Equal(t, context.DeadlineExceeded, errors.Cause(err))
+	assert.Equal(t, int32(1), atomic.LoadInt32(&deleted), "session was not deleted")
+
+	err = <-done
+	assert.Equal(t, ErrClientClosed, errors.Cause(err))
+}
+
+func TestGetSessionExpInSecUserAPIKey(t *testing.T) {
+	t.Parallel()
+
+	c := NewClient(context.Background(), BaseURLSandbox, nil, "", "")
+	c.sessionServerContext = &sessionServer{}
+	c.sessionServerContext.UserAPIKey.ID = 1
+	c.updateUserFlag()
+
+	_, err := c.getSessionExpInSec()
+	assert.Error(t, err)
+
+	c.sessionServerContext.UserAPIKey.GrantedByUser.UserPerson.ID = 6084
+	c.sessionServerContext.UserAPIKey.GrantedByUser.UserPerson.SessionTimeout = 3600
+
+	exp, err := c.getSessionExpInSec()
+
+	assert.NoError(t, err)
+	assert.Equal(t, int64(3600), exp)
+}
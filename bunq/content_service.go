@@ -1,6 +1,7 @@
 package bunq
 
 import (
+	"context"
 	"encoding/base64"
 	"fmt"
 	"github.com/pkg/errors"
@@ -12,7 +13,12 @@ import (
 type contentService service
 
 func (c *contentService) GetAttachmentPublic(id string) (string, error) {
-	res, err := c.client.preformRequest(http.MethodGet, c.client.formatRequestURL(fmt.Sprintf("attachment-public/%s/content", id)), nil)
+	return c.GetAttachmentPublicCtx(context.Background(), id)
+}
+
+// GetAttachmentPublicCtx is GetAttachmentPublic with a context for the request.
+func (c *contentService) GetAttachmentPublicCtx(ctx context.Context, id string) (string, error) {
+	res, err := c.client.preformRequest(ctx, http.MethodGet, c.client.formatRequestURL(fmt.Sprintf("attachment-public/%s/content", id)), nil)
 	if err != nil {
 		return "", errors.Wrap(err, "bunq: request to get attachment content failed")
 	}
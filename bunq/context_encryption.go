@@ -0,0 +1,194 @@
+package bunq
+
+import (
+	"context"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/binary"
+	"encoding/json"
+	"fmt"
+	"io"
+
+	"github.com/pkg/errors"
+)
+
+const (
+	encryptedContextVersion    int    = 1
+	encryptedContextKDF        string = "pbkdf2-sha256"
+	encryptedContextIterations int    = 600000
+	encryptedContextSaltSize   int    = 16
+	encryptedContextKeySize    int    = 32
+)
+
+// encryptedClientContext is the envelope an encrypted client context is stored in. The header fields
+// are authenticated together with the ciphertext, so they can not be altered without detection.
+type encryptedClientContext struct {
+	Version    int    `json:"version"`
+	KDF        string `json:"kdf"`
+	Iterations int    `json:"iterations"`
+	Salt       []byte `json:"salt"`
+	Nonce      []byte `json:"nonce"`
+	Ciphertext []byte `json:"ciphertext"`
+}
+
+func (e *encryptedClientContext) additionalData() []byte {
+	return []byte(fmt.Sprintf("bunq-client-context:%d:%s:%d", e.Version, e.KDF, e.Iterations))
+}
+
+// ExportEncryptedClientContext exports the client context of the current client encrypted with the
+// passphrase. Use NewClientFromEncryptedContext to recreate the client.
+func (c *Client) ExportEncryptedClientContext(passphrase string) ([]byte, error) {
+	clientCtx, err := c.ExportClientContext()
+	if err != nil {
+		return nil, err
+	}
+
+	return EncryptClientContext(&clientCtx, passphrase)
+}
+
+// NewClientFromEncryptedContext creates a new bunq client from a client context exported with
+// ExportEncryptedClientContext.
+func NewClientFromEncryptedContext(ctx context.Context, data []byte, passphrase string) (*Client, error) {
+	clientCtx, err := DecryptClientContext(data, passphrase)
+	if err != nil {
+		return nil, err
+	}
+
+	return NewClientFromContext(ctx, clientCtx)
+}
+
+// EncryptClientContext encrypts the client context with AES-256-GCM using a key derived from the passphrase.
+func EncryptClientContext(clientCtx *ClientContext, passphrase string) ([]byte, error) {
+	if passphrase == "" {
+		return nil, errors.New("bunq: passphrase can not be empty")
+	}
+
+	plain, err := json.Marshal(clientCtx)
+	if err != nil {
+		return nil, errors.Wrap(err, "bunq: could not marshal client context")
+	}
+
+	envelope := encryptedClientContext{
+		Version:    encryptedContextVersion,
+		KDF:        encryptedContextKDF,
+		Iterations: encryptedContextIterations,
+		Salt:       make([]byte, encryptedContextSaltSize),
+	}
+
+	_, err = io.ReadFull(rand.Reader, envelope.Salt)
+	if err != nil {
+		return nil, errors.Wrap(err, "bunq: could not generate salt")
+	}
+
+	aead, err := newClientContextAEAD(passphrase, &envelope)
+	if err != nil {
+		return nil, err
+	}
+
+	envelope.Nonce = make([]byte, aead.NonceSize())
+
+	_, err = io.ReadFull(rand.Reader, envelope.Nonce)
+	if err != nil {
+		return nil, errors.Wrap(err, "bunq: could not generate nonce")
+	}
+
+	envelope.Ciphertext = aead.Seal(nil, envelope.Nonce, plain, envelope.additionalData())
+
+	return json.Marshal(envelope)
+}
+
+// DecryptClientContext decrypts a client context encrypted with EncryptClientContext.
+func DecryptClientContext(data []byte, passphrase string) (*ClientContext, error) {
+	var envelope encryptedClientContext
+
+	err := json.Unmarshal(data, &envelope)
+	if err != nil {
+		return nil, errors.Wrap(err, "bunq: could not parse encrypted client context")
+	}
+
+	if envelope.Version != encryptedContextVersion {
+		return nil, fmt.Errorf("bunq: unsupported encrypted client context version %d", envelope.Version)
+	}
+
+	aead, err := newClientContextAEAD(passphrase, &envelope)
+	if err != nil {
+		return nil, err
+	}
+
+	plain, err := aead.Open(nil, envelope.Nonce, envelope.Ciphertext, envelope.additionalData())
+	if err != nil {
+		return nil, errors.New("bunq: could not decrypt client context, wrong passphrase or corrupted data")
+	}
+
+	var clientCtx ClientContext
+
+	err = json.Unmarshal(plain, &clientCtx)
+	if err != nil {
+		return nil, errors.Wrap(err, "bunq: could not parse client context")
+	}
+
+	return &clientCtx, nil
+}
+
+func newClientContextAEAD(passphrase string, envelope *encryptedClientContext) (cipher.AEAD, error) {
+	if envelope.KDF != encryptedContextKDF {
+		return nil, fmt.Errorf("bunq: unsupported key derivation function %q", envelope.KDF)
+	}
+
+	// The iterations are read from the envelope before it is authenticated, so they are fixed per version
+	// instead of trusted. Otherwise a tampered envelope could make the key derivation run for ever.
+	if envelope.Iterations != encryptedContextIterations || len(envelope.Salt) != encryptedContextSaltSize {
+		return nil, errors.New("bunq: invalid key derivation parameters")
+	}
+
+	key := pbkdf2SHA256([]byte(passphrase), envelope.Salt, envelope.Iterations, encryptedContextKeySize)
+
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, errors.Wrap(err, "bunq: could not create cipher")
+	}
+
+	aead, err := cipher.NewGCM(block)
+	if err != nil {
+		return nil, errors.Wrap(err, "bunq: could not create cipher")
+	}
+
+	return aead, nil
+}
+
+// pbkdf2SHA256 derives a key as described in RFC 8018 using HMAC-SHA256 as the pseudorandom function.
+func pbkdf2SHA256(password, salt []byte, iterations, keyLen int) []byte {
+	prf := hmac.New(sha256.New, password)
+	hashLen := prf.Size()
+	numBlocks := (keyLen + hashLen - 1) / hashLen
+
+	var blockIndex [4]byte
+	dk := make([]byte, 0, numBlocks*hashLen)
+	u := make([]byte, hashLen)
+
+	for block := 1; block <= numBlocks; block++ {
+		prf.Reset()
+		prf.Write(salt)
+		binary.BigEndian.PutUint32(blockIndex[:], uint32(block))
+		prf.Write(blockIndex[:])
+		dk = prf.Sum(dk)
+
+		t := dk[len(dk)-hashLen:]
+		copy(u, t)
+
+		for n := 2; n <= iterations; n++ {
+			prf.Reset()
+			prf.Write(u)
+			u = prf.Sum(u[:0])
+
+			for i := range u {
+				t[i] ^= u[i]
+			}
+		}
+	}
+
+	return dk[:keyLen]
+}
@@ -0,0 +1,99 @@
+package bunq
+
+import (
+	"context"
+	"encoding/hex"
+	"encoding/json"
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEncryptedClientContextExportAndImport(t *testing.T) {
+	t.Parallel()
+
+	c, fakeServer, cancel := createClientWithFakeServer(t)
+	defer cancel()
+	defer fakeServer.Close()
+
+	assert.NoError(t, c.Init())
+
+	data, err := c.ExportEncryptedClientContext("correct horse battery staple")
+	if !assert.NoError(t, err) {
+		return
+	}
+
+	assert.NotContains(t, string(data), "private_key")
+	assert.NotContains(t, string(data), c.sessionServerContext.Token.Token)
+
+	ctx, cancl := context.WithCancel(context.Background())
+	defer cancl()
+
+	_, err = NewClientFromEncryptedContext(ctx, data, "wrong")
+	assert.Error(t, err)
+
+	cFromCtx, err := NewClientFromEncryptedContext(ctx, data, "correct horse battery staple")
+	if assert.NoError(t, err) {
+		assert.True(t, c.privateKey.Equal(cFromCtx.privateKey))
+		assert.Equal(t, c.sessionServerContext, cFromCtx.sessionServerContext)
+		assert.True(t, cFromCtx.IsUserPerson())
+	}
+}
+
+func TestDecryptClientContextTamperedHeader(t *testing.T) {
+	t.Parallel()
+
+	data, err := EncryptClientContext(&ClientContext{APIKey: "key"}, "pass")
+	if !assert.NoError(t, err) {
+		return
+	}
+
+	var envelope encryptedClientContext
+	assert.NoError(t, json.Unmarshal(data, &envelope))
+
+	envelope.Iterations++
+	tampered, _ := json.Marshal(envelope)
+
+	_, err = DecryptClientContext(tampered, "pass")
+	assert.Error(t, err)
+
+	clientCtx, err := DecryptClientContext(data, "pass")
+	if assert.NoError(t, err) {
+		assert.Equal(t, "key", clientCtx.APIKey)
+	}
+}
+
+func TestPBKDF2SHA256(t *testing.T) {
+	t.Parallel()
+
+	// Test vector from RFC 7914 section 11.
+	expected := "55ac046e56e3089fec1691c22544b605f94185216dde0465e68b9d57c20dacbc" +
+		"49ca9cccf179b645991664b39d77ef317c71b845b1e30bd509112041d3a19783"
+
+	assert.Equal(t, expected, hex.EncodeToString(pbkdf2SHA256([]byte("passwd"), []byte("salt"), 1, 64)))
+
+	expected = "4ddcd8f60b98be21830cee5ef22701f9641a4418d04c0414aeff08876b34ab56" +
+		"a1d425a1225833549adb841b51c9b3176a272bdebba1d078478f62b397f33c8d"
+
+	assert.Equal(t, expected, hex.EncodeToString(pbkdf2SHA256([]byte("Password"), []byte("NaCl"), 80000, 64)))
+}
+
+func TestDecryptClientContextIterationsOutOfRange(t *testing.T) {
+	t.Parallel()
+
+	data, err := EncryptClientContext(&ClientContext{APIKey: "key"}, "pass")
+	if !assert.NoError(t, err) {
+		return
+	}
+
+	var envelope encryptedClientContext
+	assert.NoError(t, json.Unmarshal(data, &envelope))
+
+	envelope.Iterations = math.MaxInt32
+	tampered, _ := json.Marshal(envelope)
+
+	// This must fail before the key is derived, deriving it would take hours.
+	_, err = DecryptClientContext(tampered, "pass")
+	assert.EqualError(t, err, "bunq: invalid key derivation parameters")
+}
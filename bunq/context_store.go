@@ -0,0 +1,131 @@
+package bunq
+
+import (
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sync"
+
+	"github.com/pkg/errors"
+)
+
+// ErrClientContextNotFound is returned by a ContextStore when there is no saved client context yet.
+var ErrClientContextNotFound = errors.New("bunq: client context not found")
+
+// ContextStore persists the client context, so that a client can be recreated with NewClientFromStore.
+type ContextStore interface {
+	Load() (*ClientContext, error)
+	Save(clientCtx *ClientContext) error
+}
+
+// FileContextStore is a ContextStore that keeps the client context as JSON in a file.
+type FileContextStore struct {
+	path string
+	mu   sync.Mutex
+}
+
+// NewFileContextStore creates a new FileContextStore that reads and writes the given file.
+func NewFileContextStore(path string) *FileContextStore {
+	return &FileContextStore{path: path}
+}
+
+// Load reads the client context from the file.
+func (s *FileContextStore) Load() (*ClientContext, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	raw, err := ioutil.ReadFile(s.path)
+	if os.IsNotExist(err) {
+		return nil, ErrClientContextNotFound
+	}
+
+	if err != nil {
+		return nil, errors.Wrap(err, "bunq: could not read client context")
+	}
+
+	var clientCtx ClientContext
+
+	err = json.Unmarshal(raw, &clientCtx)
+	if err != nil {
+		return nil, errors.Wrap(err, "bunq: could not parse client context")
+	}
+
+	return &clientCtx, nil
+}
+
+// Save writes the client context to a temporary file and moves it in place, so that a crash
+// never leaves a half written context behind. The file is only readable by the current user.
+func (s *FileContextStore) Save(clientCtx *ClientContext) error {
+	raw, err := json.Marshal(clientCtx)
+	if err != nil {
+		return errors.Wrap(err, "bunq: could not marshal client context")
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	tmp, err := ioutil.TempFile(filepath.Dir(s.path), filepath.Base(s.path)+".tmp")
+	if err != nil {
+		return errors.Wrap(err, "bunq: could not create temporary file for client context")
+	}
+
+	_, err = tmp.Write(raw)
+	if err == nil {
+		err = tmp.Chmod(0600)
+	}
+
+	closeErr := tmp.Close()
+	if err == nil {
+		err = closeErr
+	}
+
+	if err != nil {
+		_ = os.Remove(tmp.Name())
+		return errors.Wrap(err, "bunq: could not write client context")
+	}
+
+	err = os.Rename(tmp.Name(), s.path)
+	if err != nil {
+		_ = os.Remove(tmp.Name())
+		return errors.Wrap(err, "bunq: could not save client context")
+	}
+
+	return nil
+}
+
+// NewClientFromStore creates a new bunq client from the client context in the store. The client keeps
+// the store, so it is saved again after every session renewal.
+func NewClientFromStore(ctx context.Context, store ContextStore) (*Client, error) {
+	clientCtx, err := store.Load()
+	if err != nil {
+		return nil, err
+	}
+
+	c, err := NewClientFromContext(ctx, clientCtx)
+	if err != nil {
+		return nil, err
+	}
+
+	c.SetContextStore(store)
+
+	return c, nil
+}
+
+// SetContextStore sets the store the client context is saved to after the device registration and every new
+// session. An installation without a registered device is not saved, it can not be used to create a session.
+func (c *Client) SetContextStore(store ContextStore) {
+	c.contextStore = store
+}
+
+// saveContext saves the current client context when a store has been set.
+func (c *Client) saveContext() error {
+	if c.contextStore == nil {
+		return nil
+	}
+
+	clientCtx := c.clientContext()
+
+	return errors.Wrap(c.contextStore.Save(&clientCtx), "bunq: could not save client context")
+}
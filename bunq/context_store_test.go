@@ -0,0 +1,69 @@
+package bunq
+
+import (
+	"context"
+	"fmt"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFileContextStore(t *testing.T) {
+	t.Parallel()
+
+	path := filepath.Join(t.TempDir(), "bunq.json")
+	store := NewFileContextStore(path)
+
+	_, err := store.Load()
+	assert.Equal(t, ErrClientContextNotFound, err)
+
+	c, fakeServer, cancel := createClientWithFakeServer(t)
+	defer cancel()
+	defer fakeServer.Close()
+
+	c.SetContextStore(store)
+	assert.NoError(t, c.Init())
+
+	info, err := os.Stat(path)
+	if !assert.NoError(t, err) {
+		return
+	}
+	assert.Equal(t, os.FileMode(0600), info.Mode().Perm())
+
+	ctx, cancl := context.WithCancel(context.Background())
+	defer cancl()
+	cFromStore, err := NewClientFromStore(ctx, store)
+
+	if assert.NoError(t, err) {
+		assert.True(t, c.privateKey.Equal(cFromStore.privateKey))
+		assert.Equal(t, c.installationContext, cFromStore.installationContext)
+		assert.Equal(t, c.sessionServerContext, cFromStore.sessionServerContext)
+		assert.True(t, cFromStore.IsUserPerson())
+		assert.Equal(t, store, cFromStore.contextStore)
+	}
+}
+
+func TestFileContextStoreNotSavedWithoutDevice(t *testing.T) {
+	t.Parallel()
+
+	store := NewFileContextStore(filepath.Join(t.TempDir(), "bunq.json"))
+
+	fakeServer := httptest.NewServer(createBunqFakeHandlerWithError(t, "/v1/device-server"))
+	defer fakeServer.Close()
+
+	key, err := CreateNewKeyPair()
+	assert.NoError(t, err)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	c := NewClient(ctx, fmt.Sprintf("%s/v1/", fakeServer.URL), key, "", "")
+	c.SetContextStore(store)
+
+	assert.Error(t, c.Init())
+
+	_, err = store.Load()
+	assert.Equal(t, ErrClientContextNotFound, err)
+}
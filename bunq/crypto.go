@@ -64,21 +64,26 @@ func (c *Client) verifySignature(r *http.Response) (bool, error) {
 	bodyBytes, _ := ioutil.ReadAll(r.Body)
 	r.Body = ioutil.NopCloser(bytes.NewBuffer(bodyBytes))
 
-	stringToVerify := createStringToVerify(r.Body)
-	r.Body = ioutil.NopCloser(bytes.NewBuffer(bodyBytes))
+	err := verifyServerSignature(c.serverPublicKey, bodyBytes, r.Header.Get("X-Bunq-Server-Signature"))
+
+	return err == nil, err
+}
+
+// verifyServerSignature checks that signature is bunq's base64 encoded signature of body.
+func verifyServerSignature(serverPublicKey *rsa.PublicKey, body []byte, signature string) error {
+	stringToVerify := createStringToVerify(ioutil.NopCloser(bytes.NewBuffer(body)))
 
 	h := sha256.New()
 
 	_, err := h.Write([]byte(stringToVerify))
 	if err != nil {
-		return false, errors.Wrap(err, "bunq: writing string to verify to sha failed")
+		return errors.Wrap(err, "bunq: writing string to verify to sha failed")
 	}
 
-	sigString := r.Header.Get("X-Bunq-Server-Signature")
-	sig, _ := base64.StdEncoding.DecodeString(sigString)
-	err = rsa.VerifyPKCS1v15(c.serverPublicKey, crypto.SHA256, h.Sum(nil), sig)
+	sig, _ := base64.StdEncoding.DecodeString(signature)
+	err = rsa.VerifyPKCS1v15(serverPublicKey, crypto.SHA256, h.Sum(nil), sig)
 
-	return err == nil, errors.Wrap(err, "bunq: request validation failed.")
+	return errors.Wrap(err, "bunq: request validation failed.")
 }
 
 func createStringToVerify(body io.ReadCloser) string {
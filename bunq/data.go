@@ -32,9 +32,9 @@ type common struct {
 type sessionServer struct {
 	ID          bunqID      `json:"Id"`
 	Token       token       `json:"Token"`
-	UserCompany userCompany `json:"UserCompany"`
-	UserPerson  userPerson  `json:"UserPerson"`
-	UserAPIKey  userAPIKey  `json:"UserApiKey"`
+	UserCompany UserCompany `json:"UserCompany"`
+	UserPerson  UserPerson  `json:"UserPerson"`
+	UserAPIKey  UserAPIKey  `json:"UserApiKey"`
 }
 
 // userType holds the flag of which user is currently authenticated.
@@ -44,44 +44,47 @@ type userType struct {
 	isUserAPIkey  bool
 }
 
-type user struct {
+// userCommon The fields every type of user has.
+type userCommon struct {
 	common
 	PublicUUID                         string                             `json:"public_uuid"`
-	AddressMain                        address                            `json:"address_main"`
+	AddressMain                        Address                            `json:"address_main"`
 	Alias                              []alias                            `json:"alias"`
-	AddressPostal                      address                            `json:"address_postal"`
+	AddressPostal                      Address                            `json:"address_postal"`
 	Avatar                             avatar                             `json:"avatar"`
 	Status                             string                             `json:"status"`
 	SubStatus                          string                             `json:"sub_status"`
 	Region                             string                             `json:"region"`
 	Language                           string                             `json:"language"`
 	DailyLimitWithoutConfirmationLogin dailyLimitWithoutConfirmationLogin `json:"daily_limit_without_confirmation_login"`
-	NotificationFilters                []notificationFilter               `json:"notification_filters"`
+	NotificationFilters                []NotificationFilter               `json:"notification_filters"`
 	VersionTermsOfService              string                             `json:"version_terms_of_service"`
 	SessionTimeout                     int64                              `json:"session_timeout"`
 	DisplayName                        string                             `json:"display_name"`
 	PublicNickName                     string                             `json:"public_nick_name"`
 }
 
-type userCompany struct {
-	user
+// UserCompany A business user.
+type UserCompany struct {
+	userCommon
 	Name                    string            `json:"name"`
 	Country                 string            `json:"country"`
-	Ubo                     []ubo             `json:"ubo"`
+	UBO                     []UBO             `json:"ubo"`
 	ChamberOfCommerceNumber string            `json:"chamber_of_commerce_number"`
 	TypeOfBusinessEntity    string            `json:"type_of_business_entity"`
 	SectorOfIndustry        string            `json:"sector_of_industry"`
 	CounterBankIban         string            `json:"counter_bank_iban"`
-	DirectorAlias           directorAlias     `json:"director_alias"`
+	DirectorAlias           DirectorAlias     `json:"director_alias"`
 	CardIds                 []bunqID          `json:"card_ids"`
-	CardLimits              []cardLimits      `json:"card_limits"`
-	Customer                customer          `json:"customer"`
-	CustomerLimit           customer          `json:"customer_limit"`
-	BillingContract         []billingContract `json:"billing_contract"`
+	CardLimits              []CardLimit       `json:"card_limits"`
+	Customer                Customer          `json:"customer"`
+	CustomerLimit           CustomerLimit     `json:"customer_limit"`
+	BillingContract         []BillingContract `json:"billing_contract"`
 }
 
-type userPerson struct {
-	user
+// UserPerson A private user.
+type UserPerson struct {
+	userCommon
 	FirstName                 string        `json:"first_name"`
 	MiddleName                string        `json:"middle_name"`
 	LastName                  string        `json:"last_name"`
@@ -98,7 +101,8 @@ type userPerson struct {
 	LegalName                 string        `json:"legal_name"`
 }
 
-type address struct {
+// Address A postal address.
+type Address struct {
 	Street      string `json:"street"`
 	HouseNumber string `json:"house_number"`
 	PoBox       string `json:"po_box"`
@@ -108,7 +112,8 @@ type address struct {
 	Province    string `json:"province"`
 }
 
-type ubo struct {
+// UBO An ultimate beneficial owner of a company.
+type UBO struct {
 	Name        string `json:"name"`
 	DateOfBirth string `json:"date_of_birth"`
 	Nationality string `json:"nationality"`
@@ -116,10 +121,12 @@ type ubo struct {
 
 type dailyLimitWithoutConfirmationLogin Amount
 
-type notificationFilter struct {
-	NotificationDeliveryMethod string `json:"notification_delivery_method"`
-	NotificationTarget         string `json:"notification_target"`
-	Category                   string `json:"category"`
+// NotificationFilter A notification the user or monetary account receives for a category. This is the legacy
+// form that is set through the user or monetary account object, see NotificationFilterURL for callbacks.
+type NotificationFilter struct {
+	NotificationDeliveryMethod string               `json:"notification_delivery_method"`
+	NotificationTarget         string               `json:"notification_target"`
+	Category                   NotificationCategory `json:"category"`
 }
 
 type alias struct {
@@ -141,7 +148,8 @@ type image struct {
 	Width                int    `json:"width"`
 }
 
-type directorAlias struct {
+// DirectorAlias The director of a company.
+type DirectorAlias struct {
 	UUID           string `json:"uuid"`
 	DisplayName    string `json:"display_name"`
 	Country        string `json:"country"`
@@ -149,14 +157,16 @@ type directorAlias struct {
 	PublicNickName string `json:"public_nick_name"`
 }
 
-type cardLimits struct {
+// CardLimit The daily limit of a card type.
+type CardLimit struct {
 	DailyLimit string `json:"daily_limit"`
 	Currency   string `json:"currency"`
 	Type       string `json:"type"`
 	ID         int    `json:"id"`
 }
 
-type customer struct {
+// Customer The billing details of a user.
+type Customer struct {
 	BillingAccountID              int    `json:"billing_account_id"`
 	InvoiceNotificationPreference string `json:"invoice_notification_preference"`
 	ID                            int    `json:"id"`
@@ -164,7 +174,8 @@ type customer struct {
 	Updated                       string `json:"updated"`
 }
 
-type customerLimit struct {
+// CustomerLimit The number of accounts and cards a user can have.
+type CustomerLimit struct {
 	LimitMonetaryAccount          int    `json:"limit_monetary_account"`
 	LimitCardDebitMaestro         int    `json:"limit_card_debit_maestro"`
 	LimitCardDebitMastercard      int    `json:"limit_card_debit_mastercard"`
@@ -175,12 +186,20 @@ type customerLimit struct {
 	SpentAmountMonthly            Amount `json:"spent_amount_monthly"`
 }
 
+// Amount A monetary value. Value is a decimal with two decimals, e.g. "10.50", use the methods of Amount
+// to do arithmetic on it instead of parsing it as a float.
 type Amount struct {
 	Value    string `json:"value"`
 	Currency string `json:"currency"`
 }
 
-type billingContract struct {
+// BillingContract A subscription of a user. bunq wraps every subscription in its own object.
+type BillingContract struct {
+	BillingContractSubscription BillingContractSubscription `json:"BillingContractSubscription"`
+}
+
+// BillingContractSubscription The subscription type and period of a billing contract.
+type BillingContractSubscription struct {
 	SubscriptionType          string `json:"subscription_type"`
 	ID                        int    `json:"id"`
 	Created                   string `json:"created"`
@@ -199,20 +218,17 @@ type taxResident struct {
 	Status    string `json:"status"`
 }
 
-type userAPIKey struct {
+// UserAPIKey A user that acts on behalf of another user, e.g. through OAuth.
+type UserAPIKey struct {
 	common
-	RequestedByUser requestedByUser `json:"requested_by_user"`
-	GrantedByUser   grantedByUser   `json:"granted_by_user"`
-}
-
-type grantedByUser struct {
-	UserPerson  userPerson  `json:"UserPerson"`
-	UserCompany userCompany `json:"UserCompany"`
+	RequestedByUser UserAPIKeyUser `json:"requested_by_user"`
+	GrantedByUser   UserAPIKeyUser `json:"granted_by_user"`
 }
 
-type requestedByUser struct {
-	UserPerson  userPerson  `json:"UserPerson"`
-	UserCompany userCompany `json:"UserCompany"`
+// UserAPIKeyUser The user that requested or granted an API key. Only one of the fields is set.
+type UserAPIKeyUser struct {
+	UserPerson  UserPerson  `json:"UserPerson"`
+	UserCompany UserCompany `json:"UserCompany"`
 }
 
 // Pointer The pointer alias of a monetary account
@@ -245,13 +261,13 @@ type MonetaryAccountBank struct {
 	DailySpent             Amount                 `json:"daily_spent"`
 	Description            string                 `json:"description"`
 	PublicUUID             string                 `json:"public_uuid"`
-	Status                 string                 `json:"status"`
+	Status                 MonetaryAccountStatus  `json:"status"`
 	SubStatus              string                 `json:"sub_status"`
 	Timezone               string                 `json:"timezone"`
 	UserID                 int                    `json:"user_id"`
 	MonetaryAccountProfile monetaryAccountProfile `json:"monetary_account_profile"`
-	NotificationFilters    []notificationFilter   `json:"notification_filters"`
-	Setting                monetaryAccountSetting `json:"setting"`
+	NotificationFilters    []NotificationFilter   `json:"notification_filters"`
+	Setting                MonetaryAccountSetting `json:"setting"`
 	OverdraftLimit         Amount                 `json:"overdraft_limit"`
 }
 
@@ -277,20 +293,78 @@ type monetaryAccountProfile struct {
 	ProfileAmountRequired Amount      `json:"profile_amount_required"`
 }
 
-type monetaryAccountSetting struct {
-	Color               string `json:"color"`
-	DefaultAvatarStatus string `json:"default_avatar_status"`
-	RestrictionChat     string `json:"restriction_chat"`
+// MonetaryAccountSetting The settings of a monetary account. Only the set fields are sent in an update.
+type MonetaryAccountSetting struct {
+	Color               string `json:"color,omitempty"`
+	DefaultAvatarStatus string `json:"default_avatar_status,omitempty"`
+	RestrictionChat     string `json:"restriction_chat,omitempty"`
 }
 
-type draftPayment struct {
+// MonetaryAccountStatus The status of a monetary account.
+type MonetaryAccountStatus string
+
+// The statuses of a monetary account.
+const (
+	MonetaryAccountStatusActive        MonetaryAccountStatus = "ACTIVE"
+	MonetaryAccountStatusBlocked       MonetaryAccountStatus = "BLOCKED"
+	MonetaryAccountStatusCancelled     MonetaryAccountStatus = "CANCELLED"
+	MonetaryAccountStatusPendingReopen MonetaryAccountStatus = "PENDING_REOPEN"
+)
+
+// MonetaryAccountJoint The monetary account shared by multiple users.
+type MonetaryAccountJoint struct {
+	common
+	Alias                  []Pointer              `json:"alias"`
+	Avatar                 avatar                 `json:"avatar"`
+	Balance                Amount                 `json:"balance"`
+	Country                string                 `json:"country"`
+	Currency               string                 `json:"currency"`
+	DailyLimit             Amount                 `json:"daily_limit"`
+	DailySpent             Amount                 `json:"daily_spent"`
+	Description            string                 `json:"description"`
+	PublicUUID             string                 `json:"public_uuid"`
+	Status                 MonetaryAccountStatus  `json:"status"`
+	SubStatus              string                 `json:"sub_status"`
+	Timezone               string                 `json:"timezone"`
+	UserID                 int                    `json:"user_id"`
+	MonetaryAccountProfile monetaryAccountProfile `json:"monetary_account_profile"`
+	NotificationFilters    []NotificationFilter   `json:"notification_filters"`
+	Setting                MonetaryAccountSetting `json:"setting"`
+	OverdraftLimit         Amount                 `json:"overdraft_limit"`
+	AllCoOwner             []CoOwner              `json:"all_co_owner"`
+}
+
+// GetIBANPointer returns the IBAN Pointer of this joint account.
+func (j *MonetaryAccountJoint) GetIBANPointer() *Pointer {
+	return getIBANPointer(j.Alias)
+}
+
+// CoOwner A co-owner of a joint account.
+type CoOwner struct {
+	Alias  labelUser `json:"alias"`
+	Status string    `json:"status"`
+}
+
+// DraftPaymentStatus The status of a draft payment.
+type DraftPaymentStatus string
+
+// The statuses of a draft payment. A pending draft payment can be moved to any of the other statuses.
+const (
+	DraftPaymentStatusPending   DraftPaymentStatus = "PENDING"
+	DraftPaymentStatusAccepted  DraftPaymentStatus = "ACCEPTED"
+	DraftPaymentStatusRejected  DraftPaymentStatus = "REJECTED"
+	DraftPaymentStatusCancelled DraftPaymentStatus = "CANCELLED"
+)
+
+// DraftPayment A payment, or a number of payments, that has to be accepted before it is executed.
+type DraftPayment struct {
 	common
 	MonetaryAccountID            int                 `json:"monetary_account_id"`
-	Status                       string              `json:"status"`
+	Status                       DraftPaymentStatus  `json:"status"`
 	Type                         string              `json:"type"`
 	UserAliasCreated             labelUser           `json:"user_alias_created"`
 	Responses                    interface{}         `json:"responses"`
-	Entries                      []draftPaymentEntry `json:"entries"`
+	Entries                      []DraftPaymentEntry `json:"entries"`
 	Object                       interface{}         `json:"object"`
 	RequestReferenceSplitTheBill []interface{}       `json:"request_reference_split_the_bill"`
 }
@@ -303,7 +377,8 @@ type labelUser struct {
 	PublicNickName string `json:"public_nick_name"`
 }
 
-type draftPaymentEntry struct {
+// DraftPaymentEntry A single payment of a draft payment.
+type DraftPaymentEntry struct {
 	Amount            Amount                      `json:"Amount"`
 	Alias             LabelMonetaryAccount        `json:"alias"`
 	CounterpartyAlias LabelMonetaryAccount        `json:"counterparty_alias"`
@@ -333,40 +408,70 @@ type Pagination struct {
 	OlderURL  string `json:"older_url"`
 }
 
-type masterCardAction struct {
+// MasterCardActionDecision The decision bunq made on a card transaction.
+type MasterCardActionDecision string
+
+// The most common decisions of a card transaction, bunq has a decision for every reason to reject one.
+const (
+	MasterCardActionDecisionAllowed                   MasterCardActionDecision = "ALLOWED"
+	MasterCardActionDecisionRejectedInsufficientFunds MasterCardActionDecision = "REJECTED_INSUFFICIENT_FUNDS"
+)
+
+// MasterCardActionAuthorisationStatus The authorisation status of a card transaction.
+type MasterCardActionAuthorisationStatus string
+
+// The authorisation statuses of a card transaction.
+const (
+	MasterCardActionAuthorisationStatusAuthorised MasterCardActionAuthorisationStatus = "AUTHORISED"
+	MasterCardActionAuthorisationStatusBlocked    MasterCardActionAuthorisationStatus = "BLOCKED"
+)
+
+// MasterCardActionSettlementStatus The settlement status of a card transaction.
+type MasterCardActionSettlementStatus string
+
+// The settlement statuses of a card transaction.
+const (
+	MasterCardActionSettlementStatusUnsettled         MasterCardActionSettlementStatus = "UNSETTLED"
+	MasterCardActionSettlementStatusPendingSettlement MasterCardActionSettlementStatus = "PENDING_SETTLEMENT"
+	MasterCardActionSettlementStatusSettled           MasterCardActionSettlementStatus = "SETTLED"
+)
+
+// MasterCardAction A card transaction made with a MasterCard.
+type MasterCardAction struct {
 	common
-	MonetaryAccountID             int           `json:"monetary_account_id"`
-	CardID                        int           `json:"card_id"`
-	CardAuthorisationIDResponse   string        `json:"card_authorisation_id_response"`
-	AmountLocal                   Amount        `json:"amount_local"`
-	AmountConverted               Amount        `json:"amount_converted"`
-	AmountBilling                 Amount        `json:"amount_billing"`
-	AmountOriginalLocal           Amount        `json:"amount_original_local"`
-	AmountOriginalBilling         Amount        `json:"amount_original_billing"`
-	AmountFee                     Amount        `json:"amount_fee"`
-	Decision                      string        `json:"decision"`
-	DecisionDescription           string        `json:"decision_description"`
-	DecisionDescriptionTranslated string        `json:"decision_description_translated"`
-	Description                   string        `json:"description"`
-	AuthorisationStatus           string        `json:"authorisation_status"`
-	AuthorisationType             string        `json:"authorisation_type"`
-	SettlementStatus              string        `json:"settlement_status"`
-	City                          string        `json:"city"`
-	Alias                         labelUser     `json:"alias"`
-	CounterpartyAlias             labelUser     `json:"counterparty_alias"`
-	LabelCard                     labelCard     `json:"label_card"`
-	TokenStatus                   string        `json:"token_status"`
-	ReservationExpiryTime         string        `json:"reservation_expiry_time"`
-	AllowChat                     bool          `json:"allow_chat"`
-	PanEntryModeUser              string        `json:"pan_entry_mode_user"`
-	EligibleWhitelistID           int           `json:"eligible_whitelist_id"`
-	SecureCodeID                  int           `json:"secure_code_id"`
-	WalletProviderID              string        `json:"wallet_provider_id"`
-	RequestReferenceSplitTheBill  []interface{} `json:"request_reference_split_the_bill"`
-	AppliedLimit                  string        `json:"applied_limit"`
-}
-
-type labelCard struct {
+	MonetaryAccountID             int                                 `json:"monetary_account_id"`
+	CardID                        int                                 `json:"card_id"`
+	CardAuthorisationIDResponse   string                              `json:"card_authorisation_id_response"`
+	AmountLocal                   Amount                              `json:"amount_local"`
+	AmountConverted               Amount                              `json:"amount_converted"`
+	AmountBilling                 Amount                              `json:"amount_billing"`
+	AmountOriginalLocal           Amount                              `json:"amount_original_local"`
+	AmountOriginalBilling         Amount                              `json:"amount_original_billing"`
+	AmountFee                     Amount                              `json:"amount_fee"`
+	Decision                      MasterCardActionDecision            `json:"decision"`
+	DecisionDescription           string                              `json:"decision_description"`
+	DecisionDescriptionTranslated string                              `json:"decision_description_translated"`
+	Description                   string                              `json:"description"`
+	AuthorisationStatus           MasterCardActionAuthorisationStatus `json:"authorisation_status"`
+	AuthorisationType             string                              `json:"authorisation_type"`
+	SettlementStatus              MasterCardActionSettlementStatus    `json:"settlement_status"`
+	City                          string                              `json:"city"`
+	Alias                         LabelMonetaryAccount                `json:"alias"`
+	CounterpartyAlias             LabelMonetaryAccount                `json:"counterparty_alias"`
+	LabelCard                     LabelCard                           `json:"label_card"`
+	TokenStatus                   string                              `json:"token_status"`
+	ReservationExpiryTime         string                              `json:"reservation_expiry_time"`
+	AllowChat                     bool                                `json:"allow_chat"`
+	PanEntryModeUser              string                              `json:"pan_entry_mode_user"`
+	EligibleWhitelistID           int                                 `json:"eligible_whitelist_id"`
+	SecureCodeID                  int                                 `json:"secure_code_id"`
+	WalletProviderID              string                              `json:"wallet_provider_id"`
+	RequestReferenceSplitTheBill  []RequestReference                  `json:"request_reference_split_the_bill"`
+	AppliedLimit                  string                              `json:"applied_limit"`
+}
+
+// LabelCard The label of a card as shown on its transactions.
+type LabelCard struct {
 	UUID       string    `json:"uuid"`
 	Type       string    `json:"type"`
 	SecondLine string    `json:"second_line"`
@@ -387,13 +492,13 @@ type MonetaryAccountSaving struct {
 	DailySpent             Amount                 `json:"daily_spent"`
 	Description            string                 `json:"description"`
 	PublicUUID             string                 `json:"public_uuid"`
-	Status                 string                 `json:"status"`
+	Status                 MonetaryAccountStatus  `json:"status"`
 	SubStatus              string                 `json:"sub_status"`
 	Timezone               string                 `json:"timezone"`
 	UserID                 int                    `json:"user_id"`
 	MonetaryAccountProfile monetaryAccountProfile `json:"monetary_account_profile"`
-	NotificationFilters    []notificationFilter   `json:"notification_filters"`
-	Setting                monetaryAccountSetting `json:"setting"`
+	NotificationFilters    []NotificationFilter   `json:"notification_filters"`
+	Setting                MonetaryAccountSetting `json:"setting"`
 	OverdraftLimit         Amount                 `json:"overdraft_limit"`
 	SavingsGoal            Amount                 `json:"savings_goal"`
 	SavingsGoalProgress    string                 `json:"savings_goal_progress"`
@@ -406,28 +511,41 @@ func (s *MonetaryAccountSaving) GetIBANPointer() *Pointer {
 
 type Payment struct {
 	common
-	MonetaryAccountID            int                            `json:"monetary_account_id"`
-	Amount                       Amount                         `json:"Amount"`
-	Alias                        LabelMonetaryAccount           `json:"alias"`
-	CounterpartyAlias            LabelMonetaryAccount           `json:"counterparty_alias"`
-	Description                  string                         `json:"description"`
-	Type                         string                         `json:"type"`
-	SubType                      string                         `json:"sub_type"`
-	BunqtoStatus                 string                         `json:"bunqto_status"`
-	BunqtoSubStatus              string                         `json:"bunqto_sub_status"`
-	BunqtoShareURL               string                         `json:"bunqto_share_url"`
-	BunqtoExpiry                 string                         `json:"bunqto_expiry"`
-	BunqtoTimeResponded          string                         `json:"bunqto_time_responded"`
-	Attachment                   []monetaryAccountAttachment    `json:"monetaryAccountAttachment"`
-	MerchantReference            string                         `json:"merchant_reference"`
-	BatchID                      int                            `json:"batch_id"`
-	ScheduledID                  int                            `json:"scheduled_id"`
-	AddressShipping              address                        `json:"address_shipping"`
-	AddressBilling               address                        `json:"address_billing"`
-	Geolocation                  geolocation                    `json:"geolocation"`
-	AllowChat                    bool                           `json:"allow_chat"`
-	RequestReferenceSplitTheBill []requestReferenceSplitTheBill `json:"request_reference_split_the_bill"`
-	BalanceAfterMutation         Amount                         `json:"balance_after_mutation"`
+	MonetaryAccountID            int                         `json:"monetary_account_id"`
+	Amount                       Amount                      `json:"Amount"`
+	Alias                        LabelMonetaryAccount        `json:"alias"`
+	CounterpartyAlias            LabelMonetaryAccount        `json:"counterparty_alias"`
+	Description                  string                      `json:"description"`
+	Type                         string                      `json:"type"`
+	SubType                      string                      `json:"sub_type"`
+	BunqtoStatus                 string                      `json:"bunqto_status"`
+	BunqtoSubStatus              string                      `json:"bunqto_sub_status"`
+	BunqtoShareURL               string                      `json:"bunqto_share_url"`
+	BunqtoExpiry                 string                      `json:"bunqto_expiry"`
+	BunqtoTimeResponded          string                      `json:"bunqto_time_responded"`
+	Attachment                   []monetaryAccountAttachment `json:"monetaryAccountAttachment"`
+	MerchantReference            string                      `json:"merchant_reference"`
+	BatchID                      int                         `json:"batch_id"`
+	ScheduledID                  int                         `json:"scheduled_id"`
+	AddressShipping              Address                     `json:"address_shipping"`
+	AddressBilling               Address                     `json:"address_billing"`
+	Geolocation                  geolocation                 `json:"geolocation"`
+	AllowChat                    bool                        `json:"allow_chat"`
+	RequestReferenceSplitTheBill []RequestReference          `json:"request_reference_split_the_bill"`
+	BalanceAfterMutation         Amount                      `json:"balance_after_mutation"`
+}
+
+// RequestInquiryIDs returns the ids of the request inquiries that reference this payment.
+func (p *Payment) RequestInquiryIDs() []int {
+	var ids []int
+
+	for _, ref := range p.RequestReferenceSplitTheBill {
+		if ref.Type == requestReferenceTypeRequestInquiry {
+			ids = append(ids, ref.ID)
+		}
+	}
+
+	return ids
 }
 
 // PaymentBatch a batch of payments
@@ -440,7 +558,7 @@ type ScheduledPayment struct {
 	common
 	MonetaryAccountID int                   `json:"monetary_account_id"`
 	Payment           scheduledPaymentEntry `json:"payment"`
-	Schedule          schedule              `json:"schedule"`
+	Schedule          Schedule              `json:"schedule"`
 	Status            string                `json:"status"`
 }
 
@@ -453,13 +571,29 @@ type scheduledPaymentEntry struct {
 	AllowBunqTo       bool                 `json:"allow_bunqto"`
 }
 
-type schedule struct {
-	TimeStart      string               `json:"time_start"`
-	TimeEnd        string               `json:"time_end"`
-	RecurrenceUnit string               `json:"recurrence_unit"`
-	RecurrenceSize int                  `json:"recurrence_size"`
-	Status         string               `json:"status"`
-	Object         scheduleAnchorObject `json:"object"`
+// RecurrenceUnit The unit in which the recurrence of a schedule is expressed.
+type RecurrenceUnit string
+
+// The recurrence units bunq supports.
+const (
+	RecurrenceUnitOnce    RecurrenceUnit = "ONCE"
+	RecurrenceUnitHourly  RecurrenceUnit = "HOURLY"
+	RecurrenceUnitDaily   RecurrenceUnit = "DAILY"
+	RecurrenceUnitWeekly  RecurrenceUnit = "WEEKLY"
+	RecurrenceUnitMonthly RecurrenceUnit = "MONTHLY"
+	RecurrenceUnitYearly  RecurrenceUnit = "YEARLY"
+)
+
+// Schedule When and how often a scheduled payment is executed. The times are in the bunq time format,
+// e.g. "2020-07-25 07:00:24.000000". Without TimeEnd the schedule recurs forever.
+type Schedule struct {
+	TimeStart      string                `json:"time_start"`
+	TimeEnd        string                `json:"time_end,omitempty"`
+	RecurrenceUnit RecurrenceUnit        `json:"recurrence_unit"`
+	RecurrenceSize int                   `json:"recurrence_size"`
+	TimeNext       string                `json:"time_next,omitempty"`
+	Status         string                `json:"status,omitempty"`
+	Object         *scheduleAnchorObject `json:"object,omitempty"`
 }
 
 type scheduleAnchorObject struct {
@@ -467,6 +601,35 @@ type scheduleAnchorObject struct {
 	PaymentBatch PaymentBatch `json:"paymentBatch"`
 }
 
+// ScheduleInstance A single execution of a schedule.
+type ScheduleInstance struct {
+	common
+	State                        string                 `json:"state"`
+	TimeStart                    string                 `json:"time_start"`
+	TimeEnd                      string                 `json:"time_end"`
+	ErrorMessage                 []APIErrorDescription  `json:"error_message"`
+	ScheduledObject              scheduleAnchorObject   `json:"scheduled_object"`
+	ResultObject                 scheduleInstanceResult `json:"result_object"`
+	RequestReferenceSplitTheBill []RequestReference     `json:"request_reference_split_the_bill"`
+}
+
+type scheduleInstanceResult struct {
+	Payment      *Payment      `json:"Payment,omitempty"`
+	PaymentBatch *PaymentBatch `json:"PaymentBatch,omitempty"`
+}
+
+// Payments returns the payments that were made by the schedule instance.
+func (i *ScheduleInstance) Payments() []Payment {
+	switch {
+	case i.ResultObject.Payment != nil:
+		return []Payment{*i.ResultObject.Payment}
+	case i.ResultObject.PaymentBatch != nil:
+		return i.ResultObject.PaymentBatch.Payments
+	}
+
+	return nil
+}
+
 type bunqMe struct {
 	Type  string `json:"type"`
 	Value string `json:"value"`
@@ -485,22 +648,159 @@ type geolocation struct {
 	Radius    float64 `json:"radius"`
 }
 
-type requestReferenceSplitTheBill struct {
+// RequestReference References an object that was created for or from another object, e.g. the request
+// inquiries that were sent to split the bill of a payment.
+type RequestReference struct {
 	Type string `json:"type"`
 	ID   int    `json:"id"`
 }
 
+// RequestResponseStatus The status of a request response.
+type RequestResponseStatus string
+
+// The statuses of a request response. A pending request response can be accepted or rejected.
+const (
+	RequestResponseStatusPending  RequestResponseStatus = "PENDING"
+	RequestResponseStatusAccepted RequestResponseStatus = "ACCEPTED"
+	RequestResponseStatusRejected RequestResponseStatus = "REJECTED"
+	RequestResponseStatusRevoked  RequestResponseStatus = "REVOKED"
+	RequestResponseStatusExpired  RequestResponseStatus = "EXPIRED"
+)
+
+// RequestResponse A payment request that was received from someone else.
 type RequestResponse struct {
 	common
-	SubType           string               `json:"sub_type"`
-	MonetaryAccountID int                  `json:"monetary_account_id"`
-	Amount            Amount               `json:"Amount"`
-	AmountResponded   Amount               `json:"amount_responded"`
-	AmountInquired    Amount               `json:"amount_inquired"`
-	Alias             LabelMonetaryAccount `json:"alias"`
-	CounterpartyAlias LabelMonetaryAccount `json:"counterparty_alias"`
-	Description       string               `json:"description"`
-	CreditSchemeID    string               `json:"credit_scheme_identifier"`
-	MandateID         string               `json:"mandate_identifier"`
-	Responded         string               `json:"time_responded"`
+	Status            RequestResponseStatus `json:"status"`
+	Type              string                `json:"type"`
+	SubType           string                `json:"sub_type"`
+	RequireAddress    string                `json:"require_address"`
+	MonetaryAccountID int                   `json:"monetary_account_id"`
+	Amount            Amount                `json:"Amount"`
+	AmountResponded   Amount                `json:"amount_responded"`
+	AmountInquired    Amount                `json:"amount_inquired"`
+	Alias             LabelMonetaryAccount  `json:"alias"`
+	CounterpartyAlias LabelMonetaryAccount  `json:"counterparty_alias"`
+	Description       string                `json:"description"`
+	CreditSchemeID    string                `json:"credit_scheme_identifier"`
+	MandateID         string                `json:"mandate_identifier"`
+	Responded         string                `json:"time_responded"`
+}
+
+// NotificationFilterURL A callback url that is notified about events of a category.
+type NotificationFilterURL struct {
+	common
+	Category           NotificationCategory `json:"category"`
+	NotificationTarget string               `json:"notification_target"`
+}
+
+// CardStatus The status of a card.
+type CardStatus string
+
+// The statuses a card can have. Only ACTIVE, DEACTIVATED, LOST and STOLEN can be set through an update.
+const (
+	CardStatusActive           CardStatus = "ACTIVE"
+	CardStatusDeactivated      CardStatus = "DEACTIVATED"
+	CardStatusLost             CardStatus = "LOST"
+	CardStatusStolen           CardStatus = "STOLEN"
+	CardStatusCancelled        CardStatus = "CANCELLED"
+	CardStatusExpired          CardStatus = "EXPIRED"
+	CardStatusPinTriesExceeded CardStatus = "PIN_TRIES_EXCEEDED"
+)
+
+// CardPinAssignmentType The type of a pin code assignment of a card.
+type CardPinAssignmentType string
+
+// The types of pin code assignments of a card.
+const (
+	CardPinAssignmentPrimary   CardPinAssignmentType = "PRIMARY"
+	CardPinAssignmentSecondary CardPinAssignmentType = "SECONDARY"
+	CardPinAssignmentTertiary  CardPinAssignmentType = "TERTIARY"
+)
+
+// Card A debit or credit card.
+type Card struct {
+	common
+	PublicUUID                    string                  `json:"public_uuid"`
+	Type                          string                  `json:"type"`
+	SubType                       string                  `json:"sub_type"`
+	SecondLine                    string                  `json:"second_line"`
+	NameOnCard                    string                  `json:"name_on_card"`
+	Status                        CardStatus              `json:"status"`
+	SubStatus                     string                  `json:"sub_status"`
+	OrderStatus                   string                  `json:"order_status"`
+	ExpiryDate                    string                  `json:"expiry_date"`
+	PrimaryAccountNumberFourDigit string                  `json:"primary_account_number_four_digit"`
+	CardLimit                     Amount                  `json:"card_limit"`
+	CardLimitAtm                  Amount                  `json:"card_limit_atm"`
+	CountryPermission             []CardCountryPermission `json:"country_permission"`
+	LabelMonetaryAccountOrdered   LabelMonetaryAccount    `json:"label_monetary_account_ordered"`
+	LabelMonetaryAccountCurrent   LabelMonetaryAccount    `json:"label_monetary_account_current"`
+	PinCodeAssignment             []CardPinCodeAssignment `json:"pin_code_assignment"`
+	MonetaryAccountIDFallback     int                     `json:"monetary_account_id_fallback"`
+	Country                       string                  `json:"country"`
+}
+
+// CardCountryPermission A country in which a card can be used.
+type CardCountryPermission struct {
+	ID         int    `json:"id,omitempty"`
+	Country    string `json:"country"`
+	ExpiryTime string `json:"expiry_time,omitempty"`
+}
+
+// CardPinCodeAssignment Links a pin code of a card to a monetary account.
+type CardPinCodeAssignment struct {
+	Type              CardPinAssignmentType `json:"type"`
+	RoutingType       string                `json:"routing_type,omitempty"`
+	MonetaryAccountID int                   `json:"monetary_account_id"`
+	Status            string                `json:"status,omitempty"`
+}
+
+const requestReferenceTypeRequestInquiry string = "RequestInquiry"
+
+// RequestInquiryStatus The status of a request inquiry.
+type RequestInquiryStatus string
+
+// The statuses of a request inquiry. A pending request inquiry can only be revoked.
+const (
+	RequestInquiryStatusPending  RequestInquiryStatus = "PENDING"
+	RequestInquiryStatusAccepted RequestInquiryStatus = "ACCEPTED"
+	RequestInquiryStatusRejected RequestInquiryStatus = "REJECTED"
+	RequestInquiryStatusRevoked  RequestInquiryStatus = "REVOKED"
+	RequestInquiryStatusExpired  RequestInquiryStatus = "EXPIRED"
+)
+
+// RequestInquiry A payment request that was sent to someone else.
+type RequestInquiry struct {
+	common
+	TimeResponded                string                      `json:"time_responded"`
+	TimeExpiry                   string                      `json:"time_expiry"`
+	MonetaryAccountID            int                         `json:"monetary_account_id"`
+	AmountInquired               Amount                      `json:"amount_inquired"`
+	AmountResponded              Amount                      `json:"amount_responded"`
+	UserAliasCreated             labelUser                   `json:"user_alias_created"`
+	UserAliasRevoked             labelUser                   `json:"user_alias_revoked"`
+	CounterpartyAlias            LabelMonetaryAccount        `json:"counterparty_alias"`
+	Description                  string                      `json:"description"`
+	MerchantReference            string                      `json:"merchant_reference"`
+	Attachment                   []monetaryAccountAttachment `json:"attachment"`
+	Status                       RequestInquiryStatus        `json:"status"`
+	BatchID                      int                         `json:"batch_id"`
+	ScheduledID                  int                         `json:"scheduled_id"`
+	MinimumAge                   int                         `json:"minimum_age"`
+	RequireAddress               string                      `json:"require_address"`
+	BunqmeShareURL               string                      `json:"bunqme_share_url"`
+	RedirectURL                  string                      `json:"redirect_url"`
+	AddressShipping              Address                     `json:"address_shipping"`
+	AddressBilling               Address                     `json:"address_billing"`
+	Geolocation                  geolocation                 `json:"geolocation"`
+	AllowChat                    bool                        `json:"allow_chat"`
+	RequestReferenceSplitTheBill []RequestReference          `json:"request_reference_split_the_bill"`
+}
+
+// RequestInquiryBatch A number of request inquiries that were sent together.
+type RequestInquiryBatch struct {
+	common
+	RequestInquiries             []RequestInquiry   `json:"request_inquiries"`
+	TotalAmountInquired          Amount             `json:"total_amount_inquired"`
+	RequestReferenceSplitTheBill []RequestReference `json:"reference_split_the_bill"`
 }
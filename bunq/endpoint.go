@@ -7,25 +7,57 @@ const (
 
 	endpointSessionServerCreate string = "session-server"
 
-	endpointUserPersonGet string = "user-person/%d"
+	endpointUserGet        string = "user/%d"
+	endpointUserPersonGet  string = "user-person/%d"
+	endpointUserCompanyGet string = "user-company/%d"
 
 	endpointPaymentBatchCreate string = "user/%d/monetary-account/%d/payment-batch"
 
-	endpointDraftPaymentCreate string = "user/%d/monetary-account/%d/draft-payment"
-	endpointDraftPaymentWithID string = "user/%d/monetary-account/%d/draft-payment/%d"
+	endpointDraftPaymentListing string = "user/%d/monetary-account/%d/draft-payment"
+	endpointDraftPaymentWithID  string = "user/%d/monetary-account/%d/draft-payment/%d"
 
-	endpointPaymentGet       string = "user/%d/monetary-account/%d/payment?count=200"
+	endpointPaymentListing   string = "user/%d/monetary-account/%d/payment"
+	endpointPaymentGet       string = endpointPaymentListing + "?count=200"
 	endpointPaymentGetWithID string = "user/%d/monetary-account/%d/payment/%d"
 
-	endpointScheduledPaymentGet string = "user/%d/monetary-account/%d/schedule-payment?count=200"
+	endpointScheduledPaymentListing string = "user/%d/monetary-account/%d/schedule-payment"
+	endpointScheduledPaymentGet     string = endpointScheduledPaymentListing + "?count=200"
+	endpointScheduledPaymentWithID  string = "user/%d/monetary-account/%d/schedule-payment/%d"
 
-	endpointMonetaryAccountBankListing string = "user/%d/monetary-account-bank?count=200"
+	endpointScheduledPaymentBatchCreate string = "user/%d/monetary-account/%d/schedule-payment-batch"
+	endpointScheduledPaymentBatchWithID string = "user/%d/monetary-account/%d/schedule-payment-batch/%d"
+
+	endpointScheduleInstanceListing string = "user/%d/monetary-account/%d/schedule/%d/schedule-instance"
+
+	endpointMonetaryAccountBankPath    string = "user/%d/monetary-account-bank"
+	endpointMonetaryAccountBankListing string = endpointMonetaryAccountBankPath + "?count=200"
 	endpointMonetaryAccountBankGet     string = "user/%d/monetary-account-bank/%d"
 
-	endpointMonetaryAccountSavingsListing string = "user/%d/monetary-account-savings?count=200"
+	endpointMonetaryAccountSavingsPath    string = "user/%d/monetary-account-savings"
+	endpointMonetaryAccountSavingsListing string = endpointMonetaryAccountSavingsPath + "?count=200"
 	endpointMonetaryAccountSavingsGet     string = "user/%d/monetary-account-savings/%d"
 
-	endpointMasterCardActionGet string = "user/%d/monetary-account/%d/mastercard-action/%d"
+	endpointMonetaryAccountJointPath string = "user/%d/monetary-account-joint"
+	endpointMonetaryAccountJointGet  string = "user/%d/monetary-account-joint/%d"
+
+	endpointMonetaryAccountPath    string = "user/%d/monetary-account"
+	endpointMonetaryAccountListing string = endpointMonetaryAccountPath + "?count=200"
+
+	endpointMasterCardActionListing string = "user/%d/monetary-account/%d/mastercard-action"
+	endpointMasterCardActionGet     string = "user/%d/monetary-account/%d/mastercard-action/%d"
+
+	endpointCardListing string = "user/%d/card"
+	endpointCardWithID  string = "user/%d/card/%d"
+	endpointCardReplace string = "user/%d/card/%d/replace"
+
+	endpointRequestResponsesGet   string = "user/%d/monetary-account/%d/request-response"
+	endpointRequestResponseWithID string = "user/%d/monetary-account/%d/request-response/%d"
+
+	endpointRequestInquiryListing      string = "user/%d/monetary-account/%d/request-inquiry"
+	endpointRequestInquiryWithID       string = "user/%d/monetary-account/%d/request-inquiry/%d"
+	endpointRequestInquiryBatchListing string = "user/%d/monetary-account/%d/request-inquiry-batch"
+	endpointRequestInquiryBatchWithID  string = "user/%d/monetary-account/%d/request-inquiry-batch/%d"
 
-	endpointRequestResponsesGet string = "user/%d/monetary-account/%d/request-response"
+	endpointNotificationFilterURLUser            string = "user/%d/notification-filter-url"
+	endpointNotificationFilterURLMonetaryAccount string = "user/%d/monetary-account/%d/notification-filter-url"
 )
@@ -0,0 +1,94 @@
+package bunq
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/pkg/errors"
+)
+
+const headerXBunqResponseID string = "X-Bunq-Client-Response-Id"
+
+// ErrClientClosed is returned for requests made after Client.Close has been called.
+var ErrClientClosed = errors.New("bunq: client is closed")
+
+// APIError is returned when the bunq api responds with a non 200 status code.
+// Use errors.As to get hold of it from an error returned by any of the services.
+type APIError struct {
+	StatusCode int
+	Errors     []APIErrorDescription
+	ResponseID string
+	RequestID  string
+	Method     string
+	URL        string
+}
+
+// APIErrorDescription is a single error entry as returned by bunq.
+type APIErrorDescription struct {
+	ErrorDescription           string `json:"error_description"`
+	ErrorDescriptionTranslated string `json:"error_description_translated"`
+}
+
+func newAPIError(r *http.Request, res *http.Response) *APIError {
+	apiErr := &APIError{
+		StatusCode: res.StatusCode,
+		ResponseID: res.Header.Get(headerXBunqResponseID),
+		RequestID:  r.Header.Get(headerXBunqRequestID),
+		Method:     r.Method,
+		URL:        r.URL.String(),
+	}
+
+	// bunq also sends an Error array with most 500s, only fall back to no descriptions when the body is not one.
+	apiErr.Errors = createErrorResponse(res).Error
+	if apiErr.Errors == nil {
+		apiErr.Errors = []APIErrorDescription{}
+	}
+
+	return apiErr
+}
+
+// Error implements the error interface.
+func (e *APIError) Error() string {
+	if len(e.Errors) == 0 {
+		return fmt.Sprintf(
+			"bunq: http request %s %s failed with status %d and response header: %q",
+			e.Method,
+			e.URL,
+			e.StatusCode,
+			e.ResponseID,
+		)
+	}
+
+	return fmt.Sprintf(
+		"bunq: http request %s %s failed with status %d and description %q and response header: %q",
+		e.Method,
+		e.URL,
+		e.StatusCode,
+		e.Errors[0].ErrorDescription,
+		e.ResponseID,
+	)
+}
+
+// IsNotFound returns true if err is an APIError with status 404.
+func IsNotFound(err error) bool {
+	return hasStatusCode(err, http.StatusNotFound)
+}
+
+// IsRateLimited returns true if err is an APIError with status 429.
+func IsRateLimited(err error) bool {
+	return hasStatusCode(err, http.StatusTooManyRequests)
+}
+
+// IsUnauthorized returns true if err is an APIError with status 401.
+func IsUnauthorized(err error) bool {
+	return hasStatusCode(err, http.StatusUnauthorized)
+}
+
+func hasStatusCode(err error, code int) bool {
+	var apiErr *APIError
+	if !errors.As(err, &apiErr) {
+		return false
+	}
+
+	return apiErr.StatusCode == code
+}
@@ -3,9 +3,13 @@ package bunq
 import (
 	"context"
 	"fmt"
+	"io/ioutil"
+	"net/http"
 	"net/http/httptest"
+	"strings"
 	"testing"
 
+	"github.com/pkg/errors"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -28,3 +32,60 @@ func TestErrorResponse(t *testing.T) {
 	_, err = c.deviceServer.create()
 	assert.Error(t, err)
 }
+
+func TestAPIError(t *testing.T) {
+	t.Parallel()
+
+	fakeServer := httptest.NewServer(createBunqFakeHandlerWithError(t, "/v1/user/6084/monetary-account/10111/payment/1"))
+	defer fakeServer.Close()
+
+	key, err := CreateNewKeyPair()
+	assert.NoError(t, err)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	c := NewClient(ctx, fmt.Sprintf("%s/v1/", fakeServer.URL), key, "", "")
+
+	assert.NoError(t, c.Init())
+
+	_, err = c.PaymentService.GetPayment(10111, 1)
+
+	var apiErr *APIError
+	if !assert.True(t, errors.As(err, &apiErr)) {
+		return
+	}
+
+	assert.Equal(t, http.StatusTeapot, apiErr.StatusCode)
+	assert.Equal(t, http.MethodGet, apiErr.Method)
+	assert.NotEmpty(t, apiErr.RequestID)
+	assert.Equal(t, "string", apiErr.Errors[0].ErrorDescriptionTranslated)
+	assert.False(t, IsNotFound(err))
+	assert.False(t, IsRateLimited(err))
+	assert.False(t, IsUnauthorized(err))
+}
+
+func TestAPIErrorInternalServerError(t *testing.T) {
+	t.Parallel()
+
+	r := httptest.NewRequest(http.MethodGet, BaseURLSandbox+"user/6084", nil)
+
+	res := &http.Response{
+		StatusCode: http.StatusInternalServerError,
+		Header:     http.Header{},
+		Body:       ioutil.NopCloser(strings.NewReader(`{"Error":[{"error_description":"Something went wrong."}]}`)),
+	}
+
+	apiErr := newAPIError(r, res)
+
+	if assert.Len(t, apiErr.Errors, 1) {
+		assert.Equal(t, "Something went wrong.", apiErr.Errors[0].ErrorDescription)
+	}
+
+	res.Body = ioutil.NopCloser(strings.NewReader("<html>Internal Server Error</html>"))
+
+	apiErr = newAPIError(r, res)
+
+	assert.NotNil(t, apiErr.Errors)
+	assert.Empty(t, apiErr.Errors)
+	assert.Contains(t, apiErr.Error(), "status 500")
+}
@@ -0,0 +1,170 @@
+package bunq
+
+import (
+	"encoding/json"
+
+	"github.com/pkg/errors"
+)
+
+// The keys bunq stores the monetary account types under. Note the plural for savings accounts.
+const (
+	monetaryAccountKeyBank   string = "MonetaryAccountBank"
+	monetaryAccountKeySaving string = "MonetaryAccountSavings"
+	monetaryAccountKeyJoint  string = "MonetaryAccountJoint"
+)
+
+var monetaryAccountKeys = []string{monetaryAccountKeyBank, monetaryAccountKeySaving, monetaryAccountKeyJoint}
+
+// MonetaryAccount is implemented by every type of monetary account: *MonetaryAccountBank,
+// *MonetaryAccountSaving, *MonetaryAccountJoint and *MonetaryAccountUnknown for the types this package does not know.
+type MonetaryAccount interface {
+	GetID() int
+	GetDescription() string
+	GetBalance() Amount
+	GetStatus() MonetaryAccountStatus
+	GetIBANPointer() *Pointer
+}
+
+// GetID returns the id of the account.
+func (m *MonetaryAccountBank) GetID() int { return m.ID }
+
+// GetDescription returns the description of the account.
+func (m *MonetaryAccountBank) GetDescription() string { return m.Description }
+
+// GetBalance returns the balance of the account.
+func (m *MonetaryAccountBank) GetBalance() Amount { return m.Balance }
+
+// GetStatus returns the status of the account.
+func (m *MonetaryAccountBank) GetStatus() MonetaryAccountStatus { return m.Status }
+
+// GetID returns the id of the account.
+func (s *MonetaryAccountSaving) GetID() int { return s.ID }
+
+// GetDescription returns the description of the account.
+func (s *MonetaryAccountSaving) GetDescription() string { return s.Description }
+
+// GetBalance returns the balance of the account.
+func (s *MonetaryAccountSaving) GetBalance() Amount { return s.Balance }
+
+// GetStatus returns the status of the account.
+func (s *MonetaryAccountSaving) GetStatus() MonetaryAccountStatus { return s.Status }
+
+// GetID returns the id of the account.
+func (j *MonetaryAccountJoint) GetID() int { return j.ID }
+
+// GetDescription returns the description of the account.
+func (j *MonetaryAccountJoint) GetDescription() string { return j.Description }
+
+// GetBalance returns the balance of the account.
+func (j *MonetaryAccountJoint) GetBalance() Amount { return j.Balance }
+
+// GetStatus returns the status of the account.
+func (j *MonetaryAccountJoint) GetStatus() MonetaryAccountStatus { return j.Status }
+
+// MonetaryAccountUnknown An account of a type this package does not know, e.g. a MonetaryAccountLight. Only the
+// fields every type of account has are decoded, Raw holds the whole account.
+type MonetaryAccountUnknown struct {
+	common
+	// Type is the key bunq stores the account under, e.g. MonetaryAccountLight.
+	Type        string                `json:"-"`
+	Alias       []Pointer             `json:"alias"`
+	Balance     Amount                `json:"balance"`
+	Currency    string                `json:"currency"`
+	Description string                `json:"description"`
+	Status      MonetaryAccountStatus `json:"status"`
+	Raw         json.RawMessage       `json:"-"`
+}
+
+// GetID returns the id of the account.
+func (u *MonetaryAccountUnknown) GetID() int { return u.ID }
+
+// GetDescription returns the description of the account.
+func (u *MonetaryAccountUnknown) GetDescription() string { return u.Description }
+
+// GetBalance returns the balance of the account.
+func (u *MonetaryAccountUnknown) GetBalance() Amount { return u.Balance }
+
+// GetStatus returns the status of the account.
+func (u *MonetaryAccountUnknown) GetStatus() MonetaryAccountStatus { return u.Status }
+
+// GetIBANPointer returns the IBAN Pointer of the account, if it has one.
+func (u *MonetaryAccountUnknown) GetIBANPointer() *Pointer { return getIBANPointer(u.Alias) }
+
+func newMonetaryAccountUnknown(key string, raw json.RawMessage) (*MonetaryAccountUnknown, error) {
+	account := &MonetaryAccountUnknown{Type: key, Raw: raw}
+
+	err := json.Unmarshal(raw, account)
+	if err != nil {
+		return nil, errors.Wrap(err, "bunq: could not parse "+key)
+	}
+
+	return account, nil
+}
+
+// MonetaryAccountEntry An account in a ResponseMonetaryAccountGet, only the field of the type of the account is set.
+type MonetaryAccountEntry struct {
+	MonetaryAccountBank   *MonetaryAccountBank    `json:"MonetaryAccountBank,omitempty"`
+	MonetaryAccountSaving *MonetaryAccountSaving  `json:"MonetaryAccountSavings,omitempty"`
+	MonetaryAccountJoint  *MonetaryAccountJoint   `json:"MonetaryAccountJoint,omitempty"`
+	Unknown               *MonetaryAccountUnknown `json:"-"`
+}
+
+// UnmarshalJSON implements json.Unmarshaler, accounts of a type this package does not know are set as Unknown.
+func (e *MonetaryAccountEntry) UnmarshalJSON(data []byte) error {
+	var entry map[string]json.RawMessage
+
+	err := json.Unmarshal(data, &entry)
+	if err != nil {
+		return err
+	}
+
+	for key, raw := range entry {
+		switch key {
+		case monetaryAccountKeyBank:
+			err = json.Unmarshal(raw, &e.MonetaryAccountBank)
+		case monetaryAccountKeySaving:
+			err = json.Unmarshal(raw, &e.MonetaryAccountSaving)
+		case monetaryAccountKeyJoint:
+			err = json.Unmarshal(raw, &e.MonetaryAccountJoint)
+		default:
+			e.Unknown, err = newMonetaryAccountUnknown(key, raw)
+		}
+
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// MarshalJSON implements json.Marshaler, an Unknown account is written back under its own type.
+func (e MonetaryAccountEntry) MarshalJSON() ([]byte, error) {
+	if e.Unknown != nil {
+		return json.Marshal(map[string]json.RawMessage{e.Unknown.Type: e.Unknown.Raw})
+	}
+
+	type entry MonetaryAccountEntry
+
+	return json.Marshal(entry(e))
+}
+
+// MonetaryAccounts returns the accounts in the response regardless of their type.
+func (r *ResponseMonetaryAccountGet) MonetaryAccounts() []MonetaryAccount {
+	var accounts []MonetaryAccount
+
+	for _, entry := range r.Response {
+		switch {
+		case entry.MonetaryAccountBank != nil:
+			accounts = append(accounts, entry.MonetaryAccountBank)
+		case entry.MonetaryAccountSaving != nil:
+			accounts = append(accounts, entry.MonetaryAccountSaving)
+		case entry.MonetaryAccountJoint != nil:
+			accounts = append(accounts, entry.MonetaryAccountJoint)
+		case entry.Unknown != nil:
+			accounts = append(accounts, entry.Unknown)
+		}
+	}
+
+	return accounts
+}
@@ -0,0 +1,168 @@
+package bunq
+
+import (
+	"context"
+	"crypto/rsa"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+
+	"github.com/pkg/errors"
+)
+
+// NotificationCategory The category of the events a notification url is notified about.
+type NotificationCategory string
+
+// The categories of the notifications bunq sends to a notification url.
+const (
+	NotificationCategoryPayment                   NotificationCategory = "PAYMENT"
+	NotificationCategoryMutation                  NotificationCategory = "MUTATION"
+	NotificationCategoryCardTransactionSuccessful NotificationCategory = "CARD_TRANSACTION_SUCCESSFUL"
+	NotificationCategoryCardTransactionFailed     NotificationCategory = "CARD_TRANSACTION_FAILED"
+	NotificationCategoryRequest                   NotificationCategory = "REQUEST"
+	NotificationCategoryDraftPayment              NotificationCategory = "DRAFT_PAYMENT"
+	NotificationCategoryScheduleResult            NotificationCategory = "SCHEDULE_RESULT"
+	NotificationCategoryScheduleStatus            NotificationCategory = "SCHEDULE_STATUS"
+	NotificationCategoryBunqMeTab                 NotificationCategory = "BUNQME_TAB"
+	NotificationCategoryIdeal                     NotificationCategory = "IDEAL"
+	NotificationCategorySofort                    NotificationCategory = "SOFORT"
+	NotificationCategoryShare                     NotificationCategory = "SHARE"
+	NotificationCategoryBilling                   NotificationCategory = "BILLING"
+	NotificationCategoryChat                      NotificationCategory = "CHAT"
+	NotificationCategoryOAuth                     NotificationCategory = "OAUTH"
+	NotificationCategoryUserStatus                NotificationCategory = "USER_STATUS"
+)
+
+// maxNotificationSize is the largest notification body the NotificationHandler reads.
+const maxNotificationSize = 1 << 20
+
+// Notification A callback bunq sent to a notification url. Object holds the object the notification is about
+// keyed by its type, e.g. {"Payment": {...}}.
+type Notification struct {
+	TargetURL string               `json:"target_url"`
+	Category  NotificationCategory `json:"category"`
+	EventType string               `json:"event_type"`
+	Object    json.RawMessage      `json:"object"`
+}
+
+type notificationEnvelope struct {
+	NotificationURL Notification `json:"NotificationUrl"`
+}
+
+type notificationObject struct {
+	Payment          *Payment          `json:"Payment"`
+	MasterCardAction *MasterCardAction `json:"MasterCardAction"`
+	RequestResponse  *RequestResponse  `json:"RequestResponse"`
+}
+
+// NotificationHandler is an http.Handler that receives the callbacks bunq sends to a notification url. It verifies
+// that a callback is signed by bunq and calls the callback for the category of the notification. Notifications
+// without a callback are acknowledged and dropped.
+//
+// When a callback returns an error the handler responds with a 500 so bunq retries the notification later.
+type NotificationHandler struct {
+	serverPublicKey *rsa.PublicKey
+
+	// OnPayment is called for PAYMENT and MUTATION notifications.
+	OnPayment func(ctx context.Context, n *Notification, p *Payment) error
+	// OnMasterCardAction is called for CARD_TRANSACTION_SUCCESSFUL and CARD_TRANSACTION_FAILED notifications.
+	OnMasterCardAction func(ctx context.Context, n *Notification, a *MasterCardAction) error
+	// OnRequestResponse is called for REQUEST notifications about a request that was received.
+	OnRequestResponse func(ctx context.Context, n *Notification, r *RequestResponse) error
+}
+
+// NewNotificationHandler returns a NotificationHandler that verifies callbacks with the server public key of the
+// installation of the client. The client must be initialised or created from a context.
+func (c *Client) NewNotificationHandler() *NotificationHandler {
+	return &NotificationHandler{serverPublicKey: c.serverPublicKey}
+}
+
+// ServeHTTP implements http.Handler.
+func (h *NotificationHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		http.Error(w, http.StatusText(http.StatusMethodNotAllowed), http.StatusMethodNotAllowed)
+		return
+	}
+
+	if h.serverPublicKey == nil {
+		http.Error(w, "bunq: no server public key to verify the notification with", http.StatusInternalServerError)
+		return
+	}
+
+	body, err := ioutil.ReadAll(io.LimitReader(r.Body, maxNotificationSize))
+	if err != nil {
+		http.Error(w, "bunq: could not read notification", http.StatusBadRequest)
+		return
+	}
+
+	err = verifyServerSignature(h.serverPublicKey, body, r.Header.Get("X-Bunq-Server-Signature"))
+	if err != nil {
+		http.Error(w, "bunq: invalid signature", http.StatusUnauthorized)
+		return
+	}
+
+	// A notification that can not be decoded is answered with a 400, bunq would retry it forever on a 500.
+	n, object, err := parseNotification(body)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	err = h.dispatch(r.Context(), n, object)
+	if err != nil {
+		// The error of a callback can contain details of the application, bunq only needs the status code.
+		http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+}
+
+func parseNotification(body []byte) (*Notification, *notificationObject, error) {
+	var envelope notificationEnvelope
+
+	err := json.Unmarshal(body, &envelope)
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "bunq: could not decode notification")
+	}
+
+	n := &envelope.NotificationURL
+
+	if n.Category == "" {
+		return nil, nil, errors.New("bunq: notification has no category")
+	}
+
+	var object notificationObject
+
+	if len(n.Object) > 0 {
+		err = json.Unmarshal(n.Object, &object)
+		if err != nil {
+			return nil, nil, errors.Wrap(err, fmt.Sprintf("bunq: could not decode object of %s notification", n.Category))
+		}
+	}
+
+	return n, &object, nil
+}
+
+// dispatch calls the callback for the category of n, only errors of the callbacks are returned.
+func (h *NotificationHandler) dispatch(ctx context.Context, n *Notification, object *notificationObject) error {
+	switch n.Category {
+	case NotificationCategoryPayment, NotificationCategoryMutation:
+		if h.OnPayment != nil && object.Payment != nil {
+			return h.OnPayment(ctx, n, object.Payment)
+		}
+	case NotificationCategoryCardTransactionSuccessful, NotificationCategoryCardTransactionFailed:
+		if h.OnMasterCardAction != nil && object.MasterCardAction != nil {
+			return h.OnMasterCardAction(ctx, n, object.MasterCardAction)
+		}
+	case NotificationCategoryRequest:
+		// REQUEST notifications are also sent for request inquiries, those have no callback.
+		if h.OnRequestResponse != nil && object.RequestResponse != nil {
+			return h.OnRequestResponse(ctx, n, object.RequestResponse)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,236 @@
+package bunq
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/pkg/errors"
+)
+
+type notificationFilterService service
+
+// ListUserNotificationFilters returns the callback urls of the user.
+func (n *notificationFilterService) ListUserNotificationFilters() (*ResponseNotificationFilterURLGet, error) {
+	return n.ListUserNotificationFiltersCtx(context.Background())
+}
+
+// ListUserNotificationFiltersCtx is ListUserNotificationFilters with a context for the request.
+func (n *notificationFilterService) ListUserNotificationFiltersCtx(ctx context.Context) (*ResponseNotificationFilterURLGet, error) {
+	url, err := n.userURL()
+	if err != nil {
+		return nil, err
+	}
+
+	return n.list(ctx, url)
+}
+
+// ReplaceUserNotificationFilters replaces all callback urls of the user with filters.
+func (n *notificationFilterService) ReplaceUserNotificationFilters(filters []NotificationFilterURLCreate) (*ResponseNotificationFilterURLGet, error) {
+	return n.ReplaceUserNotificationFiltersCtx(context.Background(), filters)
+}
+
+// ReplaceUserNotificationFiltersCtx is ReplaceUserNotificationFilters with a context for the request.
+func (n *notificationFilterService) ReplaceUserNotificationFiltersCtx(ctx context.Context, filters []NotificationFilterURLCreate) (*ResponseNotificationFilterURLGet, error) {
+	url, err := n.userURL()
+	if err != nil {
+		return nil, err
+	}
+
+	return n.replace(ctx, url, filters)
+}
+
+// ReplaceUserNotificationFiltersForCategory replaces the callback urls of the user for category with targets.
+// The callback urls of the other categories are kept.
+func (n *notificationFilterService) ReplaceUserNotificationFiltersForCategory(category NotificationCategory, targets ...string) (*ResponseNotificationFilterURLGet, error) {
+	return n.ReplaceUserNotificationFiltersForCategoryCtx(context.Background(), category, targets...)
+}
+
+// ReplaceUserNotificationFiltersForCategoryCtx is ReplaceUserNotificationFiltersForCategory with a context for the request.
+func (n *notificationFilterService) ReplaceUserNotificationFiltersForCategoryCtx(ctx context.Context, category NotificationCategory, targets ...string) (*ResponseNotificationFilterURLGet, error) {
+	url, err := n.userURL()
+	if err != nil {
+		return nil, err
+	}
+
+	return n.replaceCategories(ctx, url, []NotificationCategory{category}, targets)
+}
+
+// ClearUserNotificationFilters removes the callback urls of the user for the given categories, or all callback
+// urls when no category is given.
+func (n *notificationFilterService) ClearUserNotificationFilters(categories ...NotificationCategory) (*ResponseNotificationFilterURLGet, error) {
+	return n.ClearUserNotificationFiltersCtx(context.Background(), categories...)
+}
+
+// ClearUserNotificationFiltersCtx is ClearUserNotificationFilters with a context for the request.
+func (n *notificationFilterService) ClearUserNotificationFiltersCtx(ctx context.Context, categories ...NotificationCategory) (*ResponseNotificationFilterURLGet, error) {
+	url, err := n.userURL()
+	if err != nil {
+		return nil, err
+	}
+
+	return n.clear(ctx, url, categories)
+}
+
+// ListMonetaryAccountNotificationFilters returns the callback urls of the given account.
+func (n *notificationFilterService) ListMonetaryAccountNotificationFilters(monetaryAccountID int) (*ResponseNotificationFilterURLGet, error) {
+	return n.ListMonetaryAccountNotificationFiltersCtx(context.Background(), monetaryAccountID)
+}
+
+// ListMonetaryAccountNotificationFiltersCtx is ListMonetaryAccountNotificationFilters with a context for the request.
+func (n *notificationFilterService) ListMonetaryAccountNotificationFiltersCtx(ctx context.Context, monetaryAccountID int) (*ResponseNotificationFilterURLGet, error) {
+	url, err := n.monetaryAccountURL(monetaryAccountID)
+	if err != nil {
+		return nil, err
+	}
+
+	return n.list(ctx, url)
+}
+
+// ReplaceMonetaryAccountNotificationFilters replaces all callback urls of the given account with filters.
+func (n *notificationFilterService) ReplaceMonetaryAccountNotificationFilters(monetaryAccountID int, filters []NotificationFilterURLCreate) (*ResponseNotificationFilterURLGet, error) {
+	return n.ReplaceMonetaryAccountNotificationFiltersCtx(context.Background(), monetaryAccountID, filters)
+}
+
+// ReplaceMonetaryAccountNotificationFiltersCtx is ReplaceMonetaryAccountNotificationFilters with a context for the request.
+func (n *notificationFilterService) ReplaceMonetaryAccountNotificationFiltersCtx(ctx context.Context, monetaryAccountID int, filters []NotificationFilterURLCreate) (*ResponseNotificationFilterURLGet, error) {
+	url, err := n.monetaryAccountURL(monetaryAccountID)
+	if err != nil {
+		return nil, err
+	}
+
+	return n.replace(ctx, url, filters)
+}
+
+// ReplaceMonetaryAccountNotificationFiltersForCategory replaces the callback urls of the given account for category
+// with targets. The callback urls of the other categories are kept.
+func (n *notificationFilterService) ReplaceMonetaryAccountNotificationFiltersForCategory(monetaryAccountID int, category NotificationCategory, targets ...string) (*ResponseNotificationFilterURLGet, error) {
+	return n.ReplaceMonetaryAccountNotificationFiltersForCategoryCtx(context.Background(), monetaryAccountID, category, targets...)
+}
+
+// ReplaceMonetaryAccountNotificationFiltersForCategoryCtx is ReplaceMonetaryAccountNotificationFiltersForCategory
+// with a context for the request.
+func (n *notificationFilterService) ReplaceMonetaryAccountNotificationFiltersForCategoryCtx(ctx context.Context, monetaryAccountID int, category NotificationCategory, targets ...string) (*ResponseNotificationFilterURLGet, error) {
+	url, err := n.monetaryAccountURL(monetaryAccountID)
+	if err != nil {
+		return nil, err
+	}
+
+	return n.replaceCategories(ctx, url, []NotificationCategory{category}, targets)
+}
+
+// ClearMonetaryAccountNotificationFilters removes the callback urls of the given account for the given categories,
+// or all callback urls when no category is given.
+func (n *notificationFilterService) ClearMonetaryAccountNotificationFilters(monetaryAccountID int, categories ...NotificationCategory) (*ResponseNotificationFilterURLGet, error) {
+	return n.ClearMonetaryAccountNotificationFiltersCtx(context.Background(), monetaryAccountID, categories...)
+}
+
+// ClearMonetaryAccountNotificationFiltersCtx is ClearMonetaryAccountNotificationFilters with a context for the request.
+func (n *notificationFilterService) ClearMonetaryAccountNotificationFiltersCtx(ctx context.Context, monetaryAccountID int, categories ...NotificationCategory) (*ResponseNotificationFilterURLGet, error) {
+	url, err := n.monetaryAccountURL(monetaryAccountID)
+	if err != nil {
+		return nil, err
+	}
+
+	return n.clear(ctx, url, categories)
+}
+
+func (n *notificationFilterService) userURL() (string, error) {
+	userID, err := n.client.GetUserID()
+	if err != nil {
+		return "", err
+	}
+
+	return n.client.formatRequestURL(fmt.Sprintf(endpointNotificationFilterURLUser, userID)), nil
+}
+
+func (n *notificationFilterService) monetaryAccountURL(monetaryAccountID int) (string, error) {
+	userID, err := n.client.GetUserID()
+	if err != nil {
+		return "", err
+	}
+
+	return n.client.formatRequestURL(fmt.Sprintf(endpointNotificationFilterURLMonetaryAccount, userID, monetaryAccountID)), nil
+}
+
+func (n *notificationFilterService) list(ctx context.Context, url string) (*ResponseNotificationFilterURLGet, error) {
+	res, err := n.client.preformRequest(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var resStruct ResponseNotificationFilterURLGet
+
+	return &resStruct, n.client.parseResponse(res, &resStruct)
+}
+
+// replace sets the callback urls behind url. bunq has no endpoint to add or remove a single callback url, the
+// whole set is replaced on every call.
+func (n *notificationFilterService) replace(ctx context.Context, url string, filters []NotificationFilterURLCreate) (*ResponseNotificationFilterURLGet, error) {
+	if filters == nil {
+		filters = []NotificationFilterURLCreate{}
+	}
+
+	bodyRaw, err := json.Marshal(requestNotificationFilterURL{NotificationFilters: filters})
+	if err != nil {
+		return nil, errors.Wrap(err, "bunq: could not marshal body")
+	}
+
+	res, err := n.client.preformRequest(ctx, http.MethodPost, url, bytes.NewBuffer(bodyRaw))
+	if err != nil {
+		return nil, err
+	}
+
+	var resStruct ResponseNotificationFilterURLGet
+
+	return &resStruct, n.client.parseResponse(res, &resStruct)
+}
+
+func (n *notificationFilterService) replaceCategories(ctx context.Context, url string, categories []NotificationCategory, targets []string) (*ResponseNotificationFilterURLGet, error) {
+	current, err := n.list(ctx, url)
+	if err != nil {
+		return nil, err
+	}
+
+	return n.replace(ctx, url, replaceNotificationFilterCategories(current.NotificationFilters(), categories, targets))
+}
+
+func (n *notificationFilterService) clear(ctx context.Context, url string, categories []NotificationCategory) (*ResponseNotificationFilterURLGet, error) {
+	if len(categories) == 0 {
+		return n.replace(ctx, url, nil)
+	}
+
+	return n.replaceCategories(ctx, url, categories, nil)
+}
+
+// replaceNotificationFilterCategories drops the filters of categories from current and adds a filter for every
+// target. When more than one category is given the targets are added for each of them.
+func replaceNotificationFilterCategories(current []NotificationFilterURL, categories []NotificationCategory, targets []string) []NotificationFilterURLCreate {
+	filters := make([]NotificationFilterURLCreate, 0, len(current)+len(categories)*len(targets))
+
+	for _, f := range current {
+		if !containsNotificationCategory(categories, f.Category) {
+			filters = append(filters, NotificationFilterURLCreate{Category: f.Category, NotificationTarget: f.NotificationTarget})
+		}
+	}
+
+	for _, category := range categories {
+		for _, target := range targets {
+			filters = append(filters, NotificationFilterURLCreate{Category: category, NotificationTarget: target})
+		}
+	}
+
+	return filters
+}
+
+func containsNotificationCategory(categories []NotificationCategory, category NotificationCategory) bool {
+	for _, c := range categories {
+		if c == category {
+			return true
+		}
+	}
+
+	return false
+}
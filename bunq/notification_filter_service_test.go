@@ -0,0 +1,88 @@
+package bunq
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestUserNotificationFilters(t *testing.T) {
+	t.Parallel()
+
+	c, fakeServer, cancel := createClientWithFakeServer(t)
+	defer cancel()
+	defer fakeServer.Close()
+
+	assert.NoError(t, c.Init())
+
+	res, err := c.NotificationFilterService.ListUserNotificationFilters()
+
+	if assert.NoError(t, err) {
+		filters := res.NotificationFilters()
+
+		assert.Len(t, filters, 2)
+		assert.Equal(t, NotificationCategoryPayment, filters[0].Category)
+		assert.Equal(t, "https://example.com/bunq/callback", filters[0].NotificationTarget)
+	}
+
+	_, err = c.NotificationFilterService.ReplaceUserNotificationFilters([]NotificationFilterURLCreate{
+		{Category: NotificationCategoryPayment, NotificationTarget: "https://example.com/bunq/callback"},
+	})
+	assert.NoError(t, err)
+
+	_, err = c.NotificationFilterService.ReplaceUserNotificationFiltersForCategory(NotificationCategoryMutation, "https://example.com/bunq/callback")
+	assert.NoError(t, err)
+
+	_, err = c.NotificationFilterService.ClearUserNotificationFilters()
+	assert.NoError(t, err)
+}
+
+func TestMonetaryAccountNotificationFilters(t *testing.T) {
+	t.Parallel()
+
+	c, fakeServer, cancel := createClientWithFakeServer(t)
+	defer cancel()
+	defer fakeServer.Close()
+
+	assert.NoError(t, c.Init())
+
+	res, err := c.NotificationFilterService.ListMonetaryAccountNotificationFilters(monetaryAccountID)
+
+	if assert.NoError(t, err) {
+		assert.Len(t, res.NotificationFilters(), 2)
+	}
+
+	_, err = c.NotificationFilterService.ReplaceMonetaryAccountNotificationFiltersForCategory(monetaryAccountID, NotificationCategoryCardTransactionSuccessful, "https://example.com/bunq/callback")
+	assert.NoError(t, err)
+
+	_, err = c.NotificationFilterService.ClearMonetaryAccountNotificationFilters(monetaryAccountID, NotificationCategoryPayment)
+	assert.NoError(t, err)
+}
+
+func TestReplaceNotificationFilterCategories(t *testing.T) {
+	t.Parallel()
+
+	current := []NotificationFilterURL{
+		{Category: NotificationCategoryPayment, NotificationTarget: "https://a.example.com"},
+		{Category: NotificationCategoryMutation, NotificationTarget: "https://a.example.com"},
+		{Category: NotificationCategoryPayment, NotificationTarget: "https://b.example.com"},
+	}
+
+	assert.Equal(
+		t,
+		[]NotificationFilterURLCreate{
+			{Category: NotificationCategoryMutation, NotificationTarget: "https://a.example.com"},
+			{Category: NotificationCategoryPayment, NotificationTarget: "https://c.example.com"},
+		},
+		replaceNotificationFilterCategories(current, []NotificationCategory{NotificationCategoryPayment}, []string{"https://c.example.com"}),
+	)
+
+	assert.Equal(
+		t,
+		[]NotificationFilterURLCreate{
+			{Category: NotificationCategoryPayment, NotificationTarget: "https://a.example.com"},
+			{Category: NotificationCategoryPayment, NotificationTarget: "https://b.example.com"},
+		},
+		replaceNotificationFilterCategories(current, []NotificationCategory{NotificationCategoryMutation}, nil),
+	)
+}
@@ -0,0 +1,175 @@
+package bunq
+
+import (
+	"bytes"
+	"context"
+	"crypto"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"encoding/base64"
+	"errors"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func createNotificationRequest(t *testing.T, fileName string, signed bool) *http.Request {
+	body, err := ioutil.ReadFile(formatFilePathByName(fileName))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if signed {
+		return createSignedNotificationRequest(t, body)
+	}
+
+	return httptest.NewRequest(http.MethodPost, "/bunq/callback", bytes.NewBuffer(body))
+}
+
+func createSignedNotificationRequest(t *testing.T, body []byte) *http.Request {
+	r := httptest.NewRequest(http.MethodPost, "/bunq/callback", bytes.NewBuffer(body))
+
+	h := sha256.Sum256(bytes.TrimSuffix(body, []byte("\n")))
+	sig, err := rsa.SignPKCS1v15(rand.Reader, loadPrivateKey(), crypto.SHA256, h[:])
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	r.Header.Set("X-Bunq-Server-Signature", base64.StdEncoding.EncodeToString(sig))
+
+	return r
+}
+
+func createNotificationHandler(t *testing.T) *NotificationHandler {
+	c, fakeServer, cancel := createClientWithFakeServer(t)
+	defer cancel()
+	defer fakeServer.Close()
+
+	assert.NoError(t, c.Init())
+
+	return c.NewNotificationHandler()
+}
+
+func TestNotificationHandler(t *testing.T) {
+	t.Parallel()
+
+	h := createNotificationHandler(t)
+
+	var payment *Payment
+	var action *MasterCardAction
+	var response *RequestResponse
+
+	h.OnPayment = func(ctx context.Context, n *Notification, p *Payment) error {
+		assert.Equal(t, NotificationCategoryPayment, n.Category)
+		payment = p
+
+		return nil
+	}
+	h.OnMasterCardAction = func(ctx context.Context, n *Notification, a *MasterCardAction) error {
+		action = a
+
+		return nil
+	}
+	h.OnRequestResponse = func(ctx context.Context, n *Notification, r *RequestResponse) error {
+		response = r
+
+		return nil
+	}
+
+	w := httptest.NewRecorder()
+	h.ServeHTTP(w, createNotificationRequest(t, "notification_payment", true))
+
+	assert.Equal(t, http.StatusOK, w.Code)
+	if assert.NotNil(t, payment) {
+		assert.Equal(t, 261172, payment.ID)
+		assert.Equal(t, "-12.50", payment.Amount.Value)
+	}
+
+	w = httptest.NewRecorder()
+	h.ServeHTTP(w, createNotificationRequest(t, "notification_card_transaction", true))
+
+	assert.Equal(t, http.StatusOK, w.Code)
+	if assert.NotNil(t, action) {
+		assert.Equal(t, 324, action.ID)
+		assert.Equal(t, MasterCardActionDecisionAllowed, action.Decision)
+	}
+
+	w = httptest.NewRecorder()
+	h.ServeHTTP(w, createNotificationRequest(t, "notification_request_response", true))
+
+	assert.Equal(t, http.StatusOK, w.Code)
+	if assert.NotNil(t, response) {
+		assert.Equal(t, 7, response.ID)
+		assert.Equal(t, RequestResponseStatusPending, response.Status)
+	}
+}
+
+func TestNotificationHandlerInvalidSignature(t *testing.T) {
+	t.Parallel()
+
+	h := createNotificationHandler(t)
+	h.OnPayment = func(ctx context.Context, n *Notification, p *Payment) error {
+		t.Error("callback called for a notification without a valid signature")
+
+		return nil
+	}
+
+	w := httptest.NewRecorder()
+	h.ServeHTTP(w, createNotificationRequest(t, "notification_payment", false))
+
+	assert.Equal(t, http.StatusUnauthorized, w.Code)
+
+	r := createNotificationRequest(t, "notification_payment", true)
+	r.Body = ioutil.NopCloser(bytes.NewBufferString(`{"NotificationUrl":{"category":"PAYMENT"}}`))
+
+	w = httptest.NewRecorder()
+	h.ServeHTTP(w, r)
+
+	assert.Equal(t, http.StatusUnauthorized, w.Code)
+}
+
+func TestNotificationHandlerInvalidObject(t *testing.T) {
+	t.Parallel()
+
+	h := createNotificationHandler(t)
+	h.OnPayment = func(ctx context.Context, n *Notification, p *Payment) error {
+		t.Error("callback called for a notification with an invalid object")
+
+		return nil
+	}
+
+	w := httptest.NewRecorder()
+	h.ServeHTTP(w, createSignedNotificationRequest(t, []byte(`{"NotificationUrl":{"category":"PAYMENT","object":{"Payment":{"id":"not a number"}}}}`)))
+
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+}
+
+func TestNotificationHandlerCallbackError(t *testing.T) {
+	t.Parallel()
+
+	h := createNotificationHandler(t)
+	h.OnPayment = func(ctx context.Context, n *Notification, p *Payment) error {
+		return errors.New("database is down")
+	}
+
+	w := httptest.NewRecorder()
+	h.ServeHTTP(w, createNotificationRequest(t, "notification_payment", true))
+
+	assert.Equal(t, http.StatusInternalServerError, w.Code)
+	assert.NotContains(t, w.Body.String(), "database is down")
+
+	// Notifications without a callback are acknowledged.
+	w = httptest.NewRecorder()
+	h.ServeHTTP(w, createNotificationRequest(t, "notification_card_transaction", true))
+
+	assert.Equal(t, http.StatusOK, w.Code)
+
+	w = httptest.NewRecorder()
+	h.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/bunq/callback", nil))
+
+	assert.Equal(t, http.StatusMethodNotAllowed, w.Code)
+}
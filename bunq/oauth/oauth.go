@@ -0,0 +1,165 @@
+// Package oauth implements the OAuth authorization code flow of bunq, so an app can act on behalf of other
+// bunq users: https://doc.bunq.com/#/oauth
+//
+// The user is sent to the url of AuthCodeURL, bunq redirects the user back to the redirect url with a code,
+// Exchange trades that code for an access token and NewClient creates a bunq client that uses the access
+// token as its API key.
+package oauth
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+
+	"github.com/OGKevin/go-bunq/bunq"
+	"github.com/pkg/errors"
+)
+
+// Endpoint The urls bunq uses for the OAuth flow.
+type Endpoint struct {
+	AuthURL  string
+	TokenURL string
+}
+
+var (
+	// EndpointProduction The OAuth endpoint of the production api.
+	EndpointProduction = Endpoint{
+		AuthURL:  "https://oauth.bunq.com/auth",
+		TokenURL: "https://api.oauth.bunq.com/v1/token",
+	}
+	// EndpointSandbox The OAuth endpoint of the sandbox api.
+	EndpointSandbox = Endpoint{
+		AuthURL:  "https://oauth.sandbox.bunq.com/auth",
+		TokenURL: "https://api-oauth.sandbox.bunq.com/v1/token",
+	}
+)
+
+// Config The OAuth client as registered in the bunq app.
+type Config struct {
+	ClientID     string
+	ClientSecret string
+	RedirectURL  string
+	Endpoint     Endpoint
+
+	// HTTPClient is used to exchange codes, http.DefaultClient is used when it is nil.
+	HTTPClient *http.Client
+}
+
+// Token The access token bunq grants in exchange for a code. AccessToken is used as the API key of a client.
+type Token struct {
+	AccessToken string `json:"access_token"`
+	TokenType   string `json:"token_type"`
+	State       string `json:"state"`
+}
+
+type responseTokenError struct {
+	Error            string `json:"error"`
+	ErrorDescription string `json:"error_description"`
+}
+
+// NewState returns a random value to pass as the state to AuthCodeURL. Store it, e.g. in the session of the
+// user, and compare it with the state bunq redirects back with to protect against cross site request forgery.
+func NewState() (string, error) {
+	b := make([]byte, 16)
+
+	_, err := rand.Read(b)
+	if err != nil {
+		return "", errors.Wrap(err, "bunq: oauth: could not generate state")
+	}
+
+	return hex.EncodeToString(b), nil
+}
+
+// AuthCodeURL returns the url to send the user to, to grant the app access to their account.
+func (c *Config) AuthCodeURL(state string) string {
+	v := url.Values{}
+	v.Set("response_type", "code")
+	v.Set("client_id", c.ClientID)
+	v.Set("redirect_uri", c.RedirectURL)
+	v.Set("state", state)
+
+	return c.Endpoint.AuthURL + "?" + v.Encode()
+}
+
+// Exchange trades the code bunq redirected the user back with for an access token.
+func (c *Config) Exchange(ctx context.Context, code string) (*Token, error) {
+	v := url.Values{}
+	v.Set("grant_type", "authorization_code")
+	v.Set("code", code)
+	v.Set("redirect_uri", c.RedirectURL)
+	v.Set("client_id", c.ClientID)
+	v.Set("client_secret", c.ClientSecret)
+
+	// bunq expects the parameters in the query, not in the body.
+	r, err := http.NewRequestWithContext(ctx, http.MethodPost, c.Endpoint.TokenURL+"?"+v.Encode(), nil)
+	if err != nil {
+		return nil, errors.Wrap(err, "bunq: oauth: could not create token request")
+	}
+
+	res, err := c.httpClient().Do(r)
+	if err != nil {
+		return nil, errors.Wrap(err, "bunq: oauth: token request failed")
+	}
+	defer res.Body.Close()
+
+	body, err := ioutil.ReadAll(res.Body)
+	if err != nil {
+		return nil, errors.Wrap(err, "bunq: oauth: could not read token response")
+	}
+
+	if res.StatusCode != http.StatusOK {
+		var resErr responseTokenError
+		_ = json.Unmarshal(body, &resErr)
+
+		return nil, fmt.Errorf("bunq: oauth: token request failed with status %d: %s %s", res.StatusCode, resErr.Error, resErr.ErrorDescription)
+	}
+
+	var token Token
+
+	err = json.Unmarshal(body, &token)
+	if err != nil {
+		return nil, errors.Wrap(err, "bunq: oauth: parsing token response failed")
+	}
+
+	if token.AccessToken == "" {
+		return nil, errors.New("bunq: oauth: token response has no access token")
+	}
+
+	return &token, nil
+}
+
+// NewClient creates and initialises a bunq client that acts on behalf of the user that granted token. baseURL
+// must be the api that matches the Endpoint of the config, e.g. bunq.BaseURLSandbox for EndpointSandbox.
+// The session of the client belongs to a UserAPIKey, use GetUserID of the client for the id to use in requests.
+func (c *Config) NewClient(ctx context.Context, baseURL string, key *rsa.PrivateKey, token *Token, description string) (*bunq.Client, error) {
+	client := bunq.NewClient(ctx, baseURL, key, token.AccessToken, description)
+
+	err := client.Init()
+	if err != nil {
+		_ = client.Close(ctx)
+
+		return nil, err
+	}
+
+	if !client.IsUserAPIKey() {
+		_ = client.Close(ctx)
+
+		return nil, errors.New("bunq: oauth: session does not belong to an api key user, is the token an oauth access token?")
+	}
+
+	return client, nil
+}
+
+func (c *Config) httpClient() *http.Client {
+	if c.HTTPClient != nil {
+		return c.HTTPClient
+	}
+
+	return http.DefaultClient
+}
@@ -0,0 +1,88 @@
+package oauth
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAuthCodeURL(t *testing.T) {
+	t.Parallel()
+
+	c := Config{
+		ClientID:    "client-id",
+		RedirectURL: "https://example.com/bunq/oauth",
+		Endpoint:    EndpointSandbox,
+	}
+
+	u, err := url.Parse(c.AuthCodeURL("some-state"))
+
+	if assert.NoError(t, err) {
+		assert.Equal(t, "oauth.sandbox.bunq.com", u.Host)
+		assert.Equal(t, "code", u.Query().Get("response_type"))
+		assert.Equal(t, "client-id", u.Query().Get("client_id"))
+		assert.Equal(t, "https://example.com/bunq/oauth", u.Query().Get("redirect_uri"))
+		assert.Equal(t, "some-state", u.Query().Get("state"))
+	}
+}
+
+func TestNewState(t *testing.T) {
+	t.Parallel()
+
+	a, err := NewState()
+	assert.NoError(t, err)
+
+	b, err := NewState()
+	assert.NoError(t, err)
+
+	assert.Len(t, a, 32)
+	assert.NotEqual(t, a, b)
+}
+
+func TestExchange(t *testing.T) {
+	t.Parallel()
+
+	fakeServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		q := r.URL.Query()
+
+		assert.Equal(t, http.MethodPost, r.Method)
+		assert.Equal(t, "authorization_code", q.Get("grant_type"))
+		assert.Equal(t, "client-id", q.Get("client_id"))
+		assert.Equal(t, "client-secret", q.Get("client_secret"))
+
+		if q.Get("code") != "valid-code" {
+			w.WriteHeader(http.StatusBadRequest)
+			_ = json.NewEncoder(w).Encode(responseTokenError{Error: "invalid_grant", ErrorDescription: "The code is invalid."})
+
+			return
+		}
+
+		_ = json.NewEncoder(w).Encode(Token{AccessToken: "access-token", TokenType: "bearer", State: "some-state"})
+	}))
+	defer fakeServer.Close()
+
+	c := Config{
+		ClientID:     "client-id",
+		ClientSecret: "client-secret",
+		RedirectURL:  "https://example.com/bunq/oauth",
+		Endpoint:     Endpoint{TokenURL: fakeServer.URL + "/v1/token"},
+	}
+
+	token, err := c.Exchange(context.Background(), "valid-code")
+
+	if assert.NoError(t, err) {
+		assert.Equal(t, "access-token", token.AccessToken)
+		assert.Equal(t, "some-state", token.State)
+	}
+
+	_, err = c.Exchange(context.Background(), "invalid-code")
+
+	if assert.Error(t, err) {
+		assert.Contains(t, err.Error(), "invalid_grant")
+	}
+}
@@ -0,0 +1,555 @@
+package bunq
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+const (
+	// MaxPageSize is the maximum amount of items bunq returns per page.
+	MaxPageSize int = 200
+
+	paginationPathPrefix string = "/v1/"
+)
+
+// PageDirection is the direction in which an iterator walks through the pages.
+type PageDirection int
+
+const (
+	// PageOlder walks from the newest item towards the oldest item.
+	PageOlder PageDirection = iota
+	// PageNewer walks from StartID towards the newest item.
+	PageNewer
+)
+
+// PageOptions configures a listing request.
+type PageOptions struct {
+	// Count is the page size, it defaults to MaxPageSize.
+	Count int
+	// Direction defaults to PageOlder.
+	Direction PageDirection
+	// StartID is the id to start after. When iterating PageNewer without a StartID, only the newest page is returned.
+	StartID int
+}
+
+func (o PageOptions) query() (string, error) {
+	count := o.Count
+	if count == 0 {
+		count = MaxPageSize
+	}
+
+	if count < 0 || count > MaxPageSize {
+		return "", fmt.Errorf("bunq: page size must be between 1 and %d, got %d", MaxPageSize, count)
+	}
+
+	q := url.Values{}
+	q.Set("count", strconv.Itoa(count))
+
+	if o.StartID != 0 {
+		switch o.Direction {
+		case PageNewer:
+			q.Set("newer_id", strconv.Itoa(o.StartID))
+		default:
+			q.Set("older_id", strconv.Itoa(o.StartID))
+		}
+	}
+
+	return q.Encode(), nil
+}
+
+func formatListingPath(path string, opts PageOptions) (string, error) {
+	q, err := opts.query()
+	if err != nil {
+		return "", err
+	}
+
+	return path + "?" + q, nil
+}
+
+// formatPaginationURL turns one of the urls in Pagination into a full request url.
+func (c *Client) formatPaginationURL(u string) string {
+	return c.formatRequestURL(strings.TrimPrefix(u, paginationPathPrefix))
+}
+
+// pageIterator walks through the pages of a listing endpoint. It yields the raw items stored
+// under one of itemKeys, the typed iterators decode them.
+type pageIterator struct {
+	client    *Client
+	itemKeys  []string
+	direction PageDirection
+	// keepUnknownItems yields the items stored under another key than itemKeys instead of skipping them.
+	keepUnknownItems bool
+
+	nextURL    string
+	items      []pageItem
+	pagination Pagination
+	err        error
+}
+
+type pageItem struct {
+	key string
+	raw json.RawMessage
+}
+
+type responsePage struct {
+	Response   []map[string]json.RawMessage `json:"Response"`
+	Pagination Pagination                   `json:"Pagination"`
+}
+
+func newPageIterator(c *Client, itemKey, path string, opts PageOptions) pageIterator {
+	it := pageIterator{
+		client:    c,
+		itemKeys:  []string{itemKey},
+		direction: opts.Direction,
+	}
+
+	p, err := formatListingPath(path, opts)
+	if err != nil {
+		it.err = err
+		return it
+	}
+
+	it.nextURL = c.formatRequestURL(p)
+
+	return it
+}
+
+// newPageIteratorFromURL creates an iterator that starts at one of the urls in Pagination.
+func newPageIteratorFromURL(c *Client, itemKey, u string, direction PageDirection) pageIterator {
+	return pageIterator{
+		client:    c,
+		itemKeys:  []string{itemKey},
+		direction: direction,
+		nextURL:   c.formatPaginationURL(u),
+	}
+}
+
+func newPageIteratorFromError(err error) pageIterator {
+	return pageIterator{err: err}
+}
+
+func (it *pageIterator) next(ctx context.Context, v interface{}) bool {
+	item, ok := it.nextItem(ctx)
+	if !ok {
+		return false
+	}
+
+	return it.decode(item.raw, v)
+}
+
+// nextItem returns the next raw item together with the key it was stored under.
+func (it *pageIterator) nextItem(ctx context.Context) (pageItem, bool) {
+	for len(it.items) == 0 {
+		if it.err != nil || it.nextURL == "" {
+			return pageItem{}, false
+		}
+
+		it.fetch(ctx)
+	}
+
+	item := it.items[0]
+	it.items = it.items[1:]
+
+	return item, true
+}
+
+func (it *pageIterator) decode(raw json.RawMessage, v interface{}) bool {
+	err := json.Unmarshal(raw, v)
+	if err != nil {
+		it.err = errors.Wrap(err, "bunq: could not parse item")
+		return false
+	}
+
+	return true
+}
+
+func (it *pageIterator) fetch(ctx context.Context) {
+	current := it.nextURL
+
+	res, err := it.client.preformRequest(ctx, http.MethodGet, current, nil)
+	if err != nil {
+		it.err = err
+		return
+	}
+
+	var page responsePage
+
+	err = it.client.parseResponse(res, &page)
+	if err != nil {
+		it.err = err
+		return
+	}
+
+	it.pagination = page.Pagination
+	it.nextURL = ""
+
+	next := page.Pagination.OlderURL
+	if it.direction == PageNewer {
+		next = page.Pagination.NewerURL
+	}
+
+	if next != "" {
+		if u := it.client.formatPaginationURL(next); u != current {
+			it.nextURL = u
+		}
+	}
+
+	for _, entry := range page.Response {
+		item, ok := it.findItem(entry)
+		if ok {
+			it.items = append(it.items, item)
+		}
+	}
+}
+
+func (it *pageIterator) findItem(entry map[string]json.RawMessage) (pageItem, bool) {
+	for _, key := range it.itemKeys {
+		if raw, ok := entry[key]; ok {
+			return pageItem{key: key, raw: raw}, true
+		}
+	}
+
+	if it.keepUnknownItems {
+		for key, raw := range entry {
+			return pageItem{key: key, raw: raw}, true
+		}
+	}
+
+	return pageItem{}, false
+}
+
+// PaymentIterator iterates over payments, fetching a new page when needed.
+type PaymentIterator struct {
+	it   pageIterator
+	item Payment
+}
+
+// Next moves to the next payment. It returns false when there are no more payments or an error occurred.
+func (i *PaymentIterator) Next(ctx context.Context) bool {
+	i.item = Payment{}
+
+	return i.it.next(ctx, &i.item)
+}
+
+// Item returns the current payment.
+func (i *PaymentIterator) Item() Payment {
+	return i.item
+}
+
+// Err returns the error that stopped the iteration, if any.
+func (i *PaymentIterator) Err() error {
+	return i.it.err
+}
+
+// Pagination returns the pagination of the last fetched page.
+func (i *PaymentIterator) Pagination() Pagination {
+	return i.it.pagination
+}
+
+// RequestResponseIterator iterates over request responses, fetching a new page when needed.
+type RequestResponseIterator struct {
+	it   pageIterator
+	item RequestResponse
+}
+
+// Next moves to the next request response. It returns false when there are no more request responses or an error occurred.
+func (i *RequestResponseIterator) Next(ctx context.Context) bool {
+	i.item = RequestResponse{}
+
+	return i.it.next(ctx, &i.item)
+}
+
+// Item returns the current request response.
+func (i *RequestResponseIterator) Item() RequestResponse {
+	return i.item
+}
+
+// Err returns the error that stopped the iteration, if any.
+func (i *RequestResponseIterator) Err() error {
+	return i.it.err
+}
+
+// ScheduledPaymentIterator iterates over scheduled payments, fetching a new page when needed.
+type ScheduledPaymentIterator struct {
+	it   pageIterator
+	item ScheduledPayment
+}
+
+// Next moves to the next scheduled payment. It returns false when there are no more scheduled payments or an error occurred.
+func (i *ScheduledPaymentIterator) Next(ctx context.Context) bool {
+	i.item = ScheduledPayment{}
+
+	return i.it.next(ctx, &i.item)
+}
+
+// Item returns the current scheduled payment.
+func (i *ScheduledPaymentIterator) Item() ScheduledPayment {
+	return i.item
+}
+
+// Err returns the error that stopped the iteration, if any.
+func (i *ScheduledPaymentIterator) Err() error {
+	return i.it.err
+}
+
+// MonetaryAccountBankIterator iterates over bank accounts, fetching a new page when needed.
+type MonetaryAccountBankIterator struct {
+	it   pageIterator
+	item MonetaryAccountBank
+}
+
+// Next moves to the next bank account. It returns false when there are no more accounts or an error occurred.
+func (i *MonetaryAccountBankIterator) Next(ctx context.Context) bool {
+	i.item = MonetaryAccountBank{}
+
+	return i.it.next(ctx, &i.item)
+}
+
+// Item returns the current bank account.
+func (i *MonetaryAccountBankIterator) Item() MonetaryAccountBank {
+	return i.item
+}
+
+// Err returns the error that stopped the iteration, if any.
+func (i *MonetaryAccountBankIterator) Err() error {
+	return i.it.err
+}
+
+// MonetaryAccountSavingIterator iterates over savings accounts, fetching a new page when needed.
+type MonetaryAccountSavingIterator struct {
+	it   pageIterator
+	item MonetaryAccountSaving
+}
+
+// Next moves to the next savings account. It returns false when there are no more accounts or an error occurred.
+func (i *MonetaryAccountSavingIterator) Next(ctx context.Context) bool {
+	i.item = MonetaryAccountSaving{}
+
+	return i.it.next(ctx, &i.item)
+}
+
+// Item returns the current savings account.
+func (i *MonetaryAccountSavingIterator) Item() MonetaryAccountSaving {
+	return i.item
+}
+
+// Err returns the error that stopped the iteration, if any.
+func (i *MonetaryAccountSavingIterator) Err() error {
+	return i.it.err
+}
+
+// CardIterator iterates over cards, fetching a new page when needed.
+type CardIterator struct {
+	it   pageIterator
+	item Card
+}
+
+// Next moves to the next card. It returns false when there are no more cards or an error occurred.
+func (i *CardIterator) Next(ctx context.Context) bool {
+	i.item = Card{}
+
+	return i.it.next(ctx, &i.item)
+}
+
+// Item returns the current card.
+func (i *CardIterator) Item() Card {
+	return i.item
+}
+
+// Err returns the error that stopped the iteration, if any.
+func (i *CardIterator) Err() error {
+	return i.it.err
+}
+
+// MasterCardActionIterator iterates over MasterCard actions, fetching a new page when needed.
+type MasterCardActionIterator struct {
+	it     pageIterator
+	item   MasterCardAction
+	filter MasterCardActionFilter
+}
+
+// Next moves to the next MasterCard action that matches the filter. It returns false when there are no more actions or an error occurred.
+func (i *MasterCardActionIterator) Next(ctx context.Context) bool {
+	for {
+		i.item = MasterCardAction{}
+
+		if !i.it.next(ctx, &i.item) {
+			return false
+		}
+
+		if i.filter.matches(&i.item) {
+			return true
+		}
+	}
+}
+
+// Item returns the current MasterCard action.
+func (i *MasterCardActionIterator) Item() MasterCardAction {
+	return i.item
+}
+
+// Err returns the error that stopped the iteration, if any.
+func (i *MasterCardActionIterator) Err() error {
+	return i.it.err
+}
+
+// DraftPaymentIterator iterates over draft payments, fetching a new page when needed.
+type DraftPaymentIterator struct {
+	it   pageIterator
+	item DraftPayment
+}
+
+// Next moves to the next draft payment. It returns false when there are no more draft payments or an error occurred.
+func (i *DraftPaymentIterator) Next(ctx context.Context) bool {
+	i.item = DraftPayment{}
+
+	return i.it.next(ctx, &i.item)
+}
+
+// Item returns the current draft payment.
+func (i *DraftPaymentIterator) Item() DraftPayment {
+	return i.item
+}
+
+// Err returns the error that stopped the iteration, if any.
+func (i *DraftPaymentIterator) Err() error {
+	return i.it.err
+}
+
+// RequestInquiryIterator iterates over request inquiries, fetching a new page when needed.
+type RequestInquiryIterator struct {
+	it   pageIterator
+	item RequestInquiry
+}
+
+// Next moves to the next request inquiry. It returns false when there are no more request inquiries or an error occurred.
+func (i *RequestInquiryIterator) Next(ctx context.Context) bool {
+	i.item = RequestInquiry{}
+
+	return i.it.next(ctx, &i.item)
+}
+
+// Item returns the current request inquiry.
+func (i *RequestInquiryIterator) Item() RequestInquiry {
+	return i.item
+}
+
+// Err returns the error that stopped the iteration, if any.
+func (i *RequestInquiryIterator) Err() error {
+	return i.it.err
+}
+
+// RequestInquiryBatchIterator iterates over request inquiry batches, fetching a new page when needed.
+type RequestInquiryBatchIterator struct {
+	it   pageIterator
+	item RequestInquiryBatch
+}
+
+// Next moves to the next request inquiry batch. It returns false when there are no more batches or an error occurred.
+func (i *RequestInquiryBatchIterator) Next(ctx context.Context) bool {
+	i.item = RequestInquiryBatch{}
+
+	return i.it.next(ctx, &i.item)
+}
+
+// Item returns the current request inquiry batch.
+func (i *RequestInquiryBatchIterator) Item() RequestInquiryBatch {
+	return i.item
+}
+
+// Err returns the error that stopped the iteration, if any.
+func (i *RequestInquiryBatchIterator) Err() error {
+	return i.it.err
+}
+
+// ScheduleInstanceIterator iterates over schedule instances, fetching a new page when needed.
+type ScheduleInstanceIterator struct {
+	it   pageIterator
+	item ScheduleInstance
+}
+
+// Next moves to the next schedule instance. It returns false when there are no more instances or an error occurred.
+func (i *ScheduleInstanceIterator) Next(ctx context.Context) bool {
+	i.item = ScheduleInstance{}
+
+	return i.it.next(ctx, &i.item)
+}
+
+// Item returns the current schedule instance.
+func (i *ScheduleInstanceIterator) Item() ScheduleInstance {
+	return i.item
+}
+
+// Err returns the error that stopped the iteration, if any.
+func (i *ScheduleInstanceIterator) Err() error {
+	return i.it.err
+}
+
+// MonetaryAccountJointIterator iterates over joint accounts, fetching a new page when needed.
+type MonetaryAccountJointIterator struct {
+	it   pageIterator
+	item MonetaryAccountJoint
+}
+
+// Next moves to the next joint account. It returns false when there are no more accounts or an error occurred.
+func (i *MonetaryAccountJointIterator) Next(ctx context.Context) bool {
+	i.item = MonetaryAccountJoint{}
+
+	return i.it.next(ctx, &i.item)
+}
+
+// Item returns the current joint account.
+func (i *MonetaryAccountJointIterator) Item() MonetaryAccountJoint {
+	return i.item
+}
+
+// Err returns the error that stopped the iteration, if any.
+func (i *MonetaryAccountJointIterator) Err() error {
+	return i.it.err
+}
+
+// MonetaryAccountIterator iterates over the accounts of every type, fetching a new page when needed.
+type MonetaryAccountIterator struct {
+	it   pageIterator
+	item MonetaryAccount
+}
+
+// Next moves to the next account. It returns false when there are no more accounts or an error occurred.
+func (i *MonetaryAccountIterator) Next(ctx context.Context) bool {
+	i.item = nil
+
+	item, ok := i.it.nextItem(ctx)
+	if !ok {
+		return false
+	}
+
+	switch item.key {
+	case monetaryAccountKeyBank:
+		i.item = &MonetaryAccountBank{}
+	case monetaryAccountKeySaving:
+		i.item = &MonetaryAccountSaving{}
+	case monetaryAccountKeyJoint:
+		i.item = &MonetaryAccountJoint{}
+	default:
+		i.item = &MonetaryAccountUnknown{Type: item.key, Raw: item.raw}
+	}
+
+	return i.it.decode(item.raw, i.item)
+}
+
+// Item returns the current account. Use a type switch to get the concrete type.
+func (i *MonetaryAccountIterator) Item() MonetaryAccount {
+	return i.item
+}
+
+// Err returns the error that stopped the iteration, if any.
+func (i *MonetaryAccountIterator) Err() error {
+	return i.it.err
+}
@@ -0,0 +1,130 @@
+package bunq
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPageOptions_query(t *testing.T) {
+	t.Parallel()
+
+	q, err := PageOptions{}.query()
+	assert.NoError(t, err)
+	assert.Equal(t, "count=200", q)
+
+	q, err = PageOptions{Count: 10, Direction: PageNewer, StartID: 5}.query()
+	assert.NoError(t, err)
+	assert.Equal(t, "count=10&newer_id=5", q)
+
+	q, err = PageOptions{StartID: 5}.query()
+	assert.NoError(t, err)
+	assert.Equal(t, "count=200&older_id=5", q)
+
+	_, err = PageOptions{Count: 201}.query()
+	assert.Error(t, err)
+}
+
+func TestPaymentIterator(t *testing.T) {
+	t.Parallel()
+
+	c, fakeServer, cancel := createClientWithFakeServer(t)
+	defer cancel()
+	defer fakeServer.Close()
+
+	assert.NoError(t, c.Init())
+
+	it := c.PaymentService.IteratePayments(10111, PageOptions{Count: 2})
+
+	var all []Payment
+	for it.Next(context.Background()) {
+		all = append(all, it.Item())
+	}
+
+	assert.NoError(t, it.Err())
+	assert.Len(t, all, 4)
+	assert.NotZero(t, all[0].ID)
+	assert.Empty(t, it.Pagination().OlderURL)
+}
+
+func TestPaymentIteratorInvalidPageSize(t *testing.T) {
+	t.Parallel()
+
+	c, fakeServer, cancel := createClientWithFakeServer(t)
+	defer cancel()
+	defer fakeServer.Close()
+
+	assert.NoError(t, c.Init())
+
+	it := c.PaymentService.IteratePayments(10111, PageOptions{Count: 500})
+
+	assert.False(t, it.Next(context.Background()))
+	assert.Error(t, it.Err())
+}
+
+func TestRequestResponseIterator(t *testing.T) {
+	t.Parallel()
+
+	c, fakeServer, cancel := createClientWithFakeServer(t)
+	defer cancel()
+	defer fakeServer.Close()
+
+	assert.NoError(t, c.Init())
+
+	it := c.RequestResponseService.IterateRequestResponses(9999, PageOptions{})
+
+	n := 0
+	for it.Next(context.Background()) {
+		assert.NotZero(t, it.Item().ID)
+		n++
+	}
+
+	assert.NoError(t, it.Err())
+	assert.Equal(t, 4, n)
+}
+
+func TestScheduledPaymentIterator(t *testing.T) {
+	t.Parallel()
+
+	c, fakeServer, cancel := createClientWithFakeServer(t)
+	defer cancel()
+	defer fakeServer.Close()
+
+	assert.NoError(t, c.Init())
+
+	it := c.ScheduledPaymentService.IterateScheduledPayments(monetaryAccountID, PageOptions{})
+
+	n := 0
+	for it.Next(context.Background()) {
+		assert.NotZero(t, it.Item().MonetaryAccountID)
+		n++
+	}
+
+	assert.NoError(t, it.Err())
+	assert.Equal(t, 2, n)
+}
+
+func TestMonetaryAccountIterators(t *testing.T) {
+	t.Parallel()
+
+	c, fakeServer, cancel := createClientWithFakeServer(t)
+	defer cancel()
+	defer fakeServer.Close()
+
+	assert.NoError(t, c.Init())
+
+	bankIt := c.AccountService.IterateMonetaryAccountBank(PageOptions{})
+	if assert.True(t, bankIt.Next(context.Background())) {
+		assert.NotZero(t, bankIt.Item().ID)
+	}
+	assert.False(t, bankIt.Next(context.Background()))
+	assert.NoError(t, bankIt.Err())
+
+	savingIt := c.AccountService.IterateMonetaryAccountSaving(PageOptions{})
+	if assert.True(t, savingIt.Next(context.Background())) {
+		assert.NotZero(t, savingIt.Item().ID)
+	}
+	assert.False(t, savingIt.Next(context.Background()))
+	assert.NoError(t, savingIt.Err())
+}
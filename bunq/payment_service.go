@@ -1,6 +1,7 @@
 package bunq
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"net/http"
@@ -10,58 +11,161 @@ import (
 
 type paymentService service
 
-func (p *paymentService) CreateDraftPayment(monetaryAccountID int, rBody requestCreateDraftPayment) (*responseBunqID, error) {
+// CreateDraftPayment creates a draft payment that has to be accepted before it is paid.
+func (p *paymentService) CreateDraftPayment(monetaryAccountID int, rBody DraftPaymentCreate) (*responseBunqID, error) {
+	return p.CreateDraftPaymentCtx(context.Background(), monetaryAccountID, rBody)
+}
+
+// CreateDraftPaymentCtx is CreateDraftPayment with a context for the request.
+func (p *paymentService) CreateDraftPaymentCtx(ctx context.Context, monetaryAccountID int, rBody DraftPaymentCreate) (*responseBunqID, error) {
 	userID, err := p.client.GetUserID()
 	if err != nil {
 		return nil, err
 	}
 
+	err = rBody.validate()
+	if err != nil {
+		return nil, err
+	}
+
 	bodyRaw, err := json.Marshal(rBody)
 	if err != nil {
 		return nil, errors.Wrap(err, "bunq: could not marshal body")
 	}
 
-	return p.client.doCURequest(p.client.formatRequestURL(fmt.Sprintf(endpointDraftPaymentCreate, userID, monetaryAccountID)), bodyRaw, http.MethodPost)
+	return p.client.doCURequest(ctx, p.client.formatRequestURL(fmt.Sprintf(endpointDraftPaymentListing, userID, monetaryAccountID)), bodyRaw, http.MethodPost)
+}
+
+// UpdateDraftPayment changes the entries or the status of a draft payment.
+func (p *paymentService) UpdateDraftPayment(id, monetaryAccountID int, rBody DraftPaymentUpdate) (*responseBunqID, error) {
+	return p.UpdateDraftPaymentCtx(context.Background(), id, monetaryAccountID, rBody)
 }
 
-func (p *paymentService) UpdateDraftPayment(id, monetaryAccountID int, rBody requestUpdateDraftPayment) (*responseBunqID, error) {
+// UpdateDraftPaymentCtx is UpdateDraftPayment with a context for the request.
+func (p *paymentService) UpdateDraftPaymentCtx(ctx context.Context, id, monetaryAccountID int, rBody DraftPaymentUpdate) (*responseBunqID, error) {
 	userID, err := p.client.GetUserID()
 	if err != nil {
 		return nil, err
 	}
 
+	err = rBody.validate()
+	if err != nil {
+		return nil, err
+	}
+
 	bodyRaw, err := json.Marshal(rBody)
 	if err != nil {
 		return nil, errors.Wrap(err, "bunq: could not marshal body")
 	}
 
-	return p.client.doCURequest(p.client.formatRequestURL(fmt.Sprintf(endpointDraftPaymentWithID, userID, monetaryAccountID, id)), bodyRaw, http.MethodPut)
+	return p.client.doCURequest(ctx, p.client.formatRequestURL(fmt.Sprintf(endpointDraftPaymentWithID, userID, monetaryAccountID, id)), bodyRaw, http.MethodPut)
+}
+
+// GetDraftPayment returns a specific draft payment for a given account.
+func (p *paymentService) GetDraftPayment(id, monetaryAccountID int) (*ResponseDraftPaymentGet, error) {
+	return p.GetDraftPaymentCtx(context.Background(), id, monetaryAccountID)
 }
 
-func (p *paymentService) GetDraftPayment(id, monetaryAccountID int) (*responseDraftPaymentGet, error) {
+// GetDraftPaymentCtx is GetDraftPayment with a context for the request.
+func (p *paymentService) GetDraftPaymentCtx(ctx context.Context, id, monetaryAccountID int) (*ResponseDraftPaymentGet, error) {
 	userID, err := p.client.GetUserID()
 	if err != nil {
 		return nil, err
 	}
 
-	res, err := p.client.preformRequest(http.MethodGet, p.client.formatRequestURL(fmt.Sprintf(endpointDraftPaymentWithID, userID, monetaryAccountID, id)), nil)
+	res, err := p.client.preformRequest(ctx, http.MethodGet, p.client.formatRequestURL(fmt.Sprintf(endpointDraftPaymentWithID, userID, monetaryAccountID, id)), nil)
 	if err != nil {
 		return nil, err
 	}
 
-	var resStruct responseDraftPaymentGet
+	var resStruct ResponseDraftPaymentGet
 
 	return &resStruct, p.client.parseResponse(res, &resStruct)
 }
 
+// AcceptDraftPayment accepts the draft payment, which pays its entries.
+func (p *paymentService) AcceptDraftPayment(id, monetaryAccountID int) (*responseBunqID, error) {
+	return p.AcceptDraftPaymentCtx(context.Background(), id, monetaryAccountID)
+}
+
+// AcceptDraftPaymentCtx is AcceptDraftPayment with a context for the requests.
+func (p *paymentService) AcceptDraftPaymentCtx(ctx context.Context, id, monetaryAccountID int) (*responseBunqID, error) {
+	return p.UpdateDraftPaymentStatusCtx(ctx, id, monetaryAccountID, DraftPaymentStatusAccepted)
+}
+
+// RejectDraftPayment rejects the draft payment.
+func (p *paymentService) RejectDraftPayment(id, monetaryAccountID int) (*responseBunqID, error) {
+	return p.RejectDraftPaymentCtx(context.Background(), id, monetaryAccountID)
+}
+
+// RejectDraftPaymentCtx is RejectDraftPayment with a context for the requests.
+func (p *paymentService) RejectDraftPaymentCtx(ctx context.Context, id, monetaryAccountID int) (*responseBunqID, error) {
+	return p.UpdateDraftPaymentStatusCtx(ctx, id, monetaryAccountID, DraftPaymentStatusRejected)
+}
+
+// CancelDraftPayment cancels the draft payment.
+func (p *paymentService) CancelDraftPayment(id, monetaryAccountID int) (*responseBunqID, error) {
+	return p.CancelDraftPaymentCtx(context.Background(), id, monetaryAccountID)
+}
+
+// CancelDraftPaymentCtx is CancelDraftPayment with a context for the requests.
+func (p *paymentService) CancelDraftPaymentCtx(ctx context.Context, id, monetaryAccountID int) (*responseBunqID, error) {
+	return p.UpdateDraftPaymentStatusCtx(ctx, id, monetaryAccountID, DraftPaymentStatusCancelled)
+}
+
+// UpdateDraftPaymentStatus moves the draft payment to the given status. It fetches the draft payment first,
+// as bunq requires the timestamp of its last update.
+func (p *paymentService) UpdateDraftPaymentStatus(id, monetaryAccountID int, status DraftPaymentStatus) (*responseBunqID, error) {
+	return p.UpdateDraftPaymentStatusCtx(context.Background(), id, monetaryAccountID, status)
+}
+
+// UpdateDraftPaymentStatusCtx is UpdateDraftPaymentStatus with a context for the requests.
+func (p *paymentService) UpdateDraftPaymentStatusCtx(ctx context.Context, id, monetaryAccountID int, status DraftPaymentStatus) (*responseBunqID, error) {
+	res, err := p.GetDraftPaymentCtx(ctx, id, monetaryAccountID)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(res.Response) == 0 {
+		return nil, fmt.Errorf("bunq: draft payment %d not found", id)
+	}
+
+	draft := res.Response[0].DraftPayment
+	if draft.Status != DraftPaymentStatusPending {
+		return nil, fmt.Errorf("bunq: draft payment %d has status %s and can not be changed to %s", id, draft.Status, status)
+	}
+
+	return p.UpdateDraftPaymentCtx(ctx, id, monetaryAccountID, DraftPaymentUpdate{
+		UpdatedTimestamp: draft.Updated,
+		Status:           &status,
+	})
+}
+
+// IterateDraftPayments returns an iterator over the draft payments of the given account.
+func (p *paymentService) IterateDraftPayments(monetaryAccountID int, opts PageOptions) *DraftPaymentIterator {
+	userID, err := p.client.GetUserID()
+	if err != nil {
+		return &DraftPaymentIterator{it: newPageIteratorFromError(err)}
+	}
+
+	return &DraftPaymentIterator{
+		it: newPageIterator(p.client, "DraftPayment", fmt.Sprintf(endpointDraftPaymentListing, userID, monetaryAccountID), opts),
+	}
+}
+
 // GetPayment returns a specific payment for a given account
 func (p *paymentService) GetPayment(monetaryAccountID uint, paymentID uint) (*ResponsePaymentGet, error) {
+	return p.GetPaymentCtx(context.Background(), monetaryAccountID, paymentID)
+}
+
+// GetPaymentCtx is GetPayment with a context for the request.
+func (p *paymentService) GetPaymentCtx(ctx context.Context, monetaryAccountID uint, paymentID uint) (*ResponsePaymentGet, error) {
 	userID, err := p.client.GetUserID()
 	if err != nil {
 		return nil, errors.Wrap(err, "bunq: payment service: could not determine user id")
 	}
 
-	res, err := p.client.preformRequest(http.MethodGet, p.client.formatRequestURL(fmt.Sprintf(endpointPaymentGetWithID, userID, monetaryAccountID, paymentID)), nil)
+	res, err := p.client.preformRequest(ctx, http.MethodGet, p.client.formatRequestURL(fmt.Sprintf(endpointPaymentGetWithID, userID, monetaryAccountID, paymentID)), nil)
 	if err != nil {
 		return nil, err
 	}
@@ -73,12 +177,17 @@ func (p *paymentService) GetPayment(monetaryAccountID uint, paymentID uint) (*Re
 
 // GetAllPayment returns all the payments for a given account
 func (p *paymentService) GetAllPayment(monetaryAccountID uint) (*ResponsePaymentGet, error) {
+	return p.GetAllPaymentCtx(context.Background(), monetaryAccountID)
+}
+
+// GetAllPaymentCtx is GetAllPayment with a context for the request.
+func (p *paymentService) GetAllPaymentCtx(ctx context.Context, monetaryAccountID uint) (*ResponsePaymentGet, error) {
 	userID, err := p.client.GetUserID()
 	if err != nil {
 		return nil, errors.Wrap(err, "bunq: payment service: could not determine user id")
 	}
 
-	res, err := p.client.preformRequest(http.MethodGet, p.client.formatRequestURL(fmt.Sprintf(endpointPaymentGet, userID, monetaryAccountID)), nil)
+	res, err := p.client.preformRequest(ctx, http.MethodGet, p.client.formatRequestURL(fmt.Sprintf(endpointPaymentGet, userID, monetaryAccountID)), nil)
 	if err != nil {
 		return nil, err
 	}
@@ -90,11 +199,16 @@ func (p *paymentService) GetAllPayment(monetaryAccountID uint) (*ResponsePayment
 
 // GetAllOlderPayment calls the older url from the Pagination
 func (p *paymentService) GetAllOlderPayment(pagi Pagination) (*ResponsePaymentGet, error) {
+	return p.GetAllOlderPaymentCtx(context.Background(), pagi)
+}
+
+// GetAllOlderPaymentCtx is GetAllOlderPayment with a context for the request.
+func (p *paymentService) GetAllOlderPaymentCtx(ctx context.Context, pagi Pagination) (*ResponsePaymentGet, error) {
 	if pagi.OlderURL == "" {
 		return nil, nil
 	}
 
-	res, err := p.client.preformRequest(http.MethodGet, p.client.formatRequestURL(pagi.OlderURL[len("/v1/"):]), nil)
+	res, err := p.client.preformRequest(ctx, http.MethodGet, p.client.formatRequestURL(pagi.OlderURL[len("/v1/"):]), nil)
 	if err != nil {
 		return nil, err
 	}
@@ -105,15 +219,126 @@ func (p *paymentService) GetAllOlderPayment(pagi Pagination) (*ResponsePaymentGe
 }
 
 func (p *paymentService) CreatePaymentBatch(monetaryAccountID int, create PaymentBatchCreate) (*responseBunqID, error) {
+	return p.CreatePaymentBatchCtx(context.Background(), monetaryAccountID, create)
+}
+
+// CreatePaymentBatchCtx is CreatePaymentBatch with a context for the request.
+func (p *paymentService) CreatePaymentBatchCtx(ctx context.Context, monetaryAccountID int, create PaymentBatchCreate) (*responseBunqID, error) {
+	userID, err := p.client.GetUserID()
+	if err != nil {
+		return nil, err
+	}
+
+	err = create.validate()
+	if err != nil {
+		return nil, err
+	}
+
+	bodyRaw, err := json.Marshal(create)
+	if err != nil {
+		return nil, errors.Wrap(err, "bunq: could not marshal body")
+	}
+
+	return p.client.doCURequest(ctx, p.client.formatRequestURL(fmt.Sprintf(endpointPaymentBatchCreate, userID, monetaryAccountID)), bodyRaw, http.MethodPost)
+}
+
+// CreatePayment creates a single payment from the given account.
+func (p *paymentService) CreatePayment(monetaryAccountID int, create PaymentCreate) (*responseBunqID, error) {
+	return p.CreatePaymentCtx(context.Background(), monetaryAccountID, create)
+}
+
+// CreatePaymentCtx is CreatePayment with a context for the request.
+func (p *paymentService) CreatePaymentCtx(ctx context.Context, monetaryAccountID int, create PaymentCreate) (*responseBunqID, error) {
 	userID, err := p.client.GetUserID()
 	if err != nil {
 		return nil, err
 	}
 
+	err = create.validate()
+	if err != nil {
+		return nil, err
+	}
+
 	bodyRaw, err := json.Marshal(create)
 	if err != nil {
 		return nil, errors.Wrap(err, "bunq: could not marshal body")
 	}
 
-	return p.client.doCURequest(p.client.formatRequestURL(fmt.Sprintf(endpointPaymentBatchCreate, userID, monetaryAccountID)), bodyRaw, http.MethodPost)
+	return p.client.doCURequest(ctx, p.client.formatRequestURL(fmt.Sprintf(endpointPaymentListing, userID, monetaryAccountID)), bodyRaw, http.MethodPost)
+}
+
+// CreatePaymentAndGet creates a single payment from the given account and fetches the created payment.
+func (p *paymentService) CreatePaymentAndGet(monetaryAccountID int, create PaymentCreate) (*Payment, error) {
+	return p.CreatePaymentAndGetCtx(context.Background(), monetaryAccountID, create)
+}
+
+// CreatePaymentAndGetCtx is CreatePaymentAndGet with a context for the requests.
+func (p *paymentService) CreatePaymentAndGetCtx(ctx context.Context, monetaryAccountID int, create PaymentCreate) (*Payment, error) {
+	created, err := p.CreatePaymentCtx(ctx, monetaryAccountID, create)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(created.Response) == 0 {
+		return nil, errors.New("bunq: create payment response did not contain an id")
+	}
+
+	paymentID := created.Response[0].ID.ID
+
+	res, err := p.GetPaymentCtx(ctx, uint(monetaryAccountID), uint(paymentID))
+	if err != nil {
+		return nil, errors.Wrap(err, fmt.Sprintf("bunq: payment %d was created but could not be fetched", paymentID))
+	}
+
+	if len(res.Response) == 0 {
+		return nil, fmt.Errorf("bunq: payment %d was created but could not be fetched", paymentID)
+	}
+
+	return &res.Response[0].Payment, nil
+}
+
+// IteratePayments returns an iterator over the payments of the given account.
+func (p *paymentService) IteratePayments(monetaryAccountID uint, opts PageOptions) *PaymentIterator {
+	userID, err := p.client.GetUserID()
+	if err != nil {
+		return &PaymentIterator{it: newPageIteratorFromError(err)}
+	}
+
+	return &PaymentIterator{
+		it: newPageIterator(p.client, "Payment", fmt.Sprintf(endpointPaymentListing, userID, monetaryAccountID), opts),
+	}
+}
+
+// SyncPayments returns the payments of the given account that were created since cursor, together with
+// the cursor for the next call. The cursor is the FutureURL or NewerURL of a Pagination.
+// With an empty cursor the newest page of payments is returned, use IteratePayments to load older history.
+func (p *paymentService) SyncPayments(monetaryAccountID uint, cursor string) (*PaymentSyncResult, error) {
+	return p.SyncPaymentsCtx(context.Background(), monetaryAccountID, cursor)
+}
+
+// SyncPaymentsCtx is SyncPayments with a context for the requests.
+func (p *paymentService) SyncPaymentsCtx(ctx context.Context, monetaryAccountID uint, cursor string) (*PaymentSyncResult, error) {
+	var it *PaymentIterator
+
+	if cursor == "" {
+		it = p.IteratePayments(monetaryAccountID, PageOptions{Direction: PageNewer})
+	} else {
+		it = &PaymentIterator{it: newPageIteratorFromURL(p.client, "Payment", cursor, PageNewer)}
+	}
+
+	result := PaymentSyncResult{Cursor: cursor}
+
+	for it.Next(ctx) {
+		result.Payments = append(result.Payments, it.Item())
+	}
+
+	if it.Err() != nil {
+		return nil, errors.Wrap(it.Err(), "bunq: payment sync failed")
+	}
+
+	if next := it.Pagination().FutureURL; next != "" {
+		result.Cursor = next
+	}
+
+	return &result, nil
 }
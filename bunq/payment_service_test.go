@@ -2,13 +2,19 @@ package bunq
 
 import (
 	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
 	"log"
+	"net/http"
+	"net/http/httptest"
+	"sync"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
 )
 
-func ExamplePaymentService_CreateBatchPayment() {
+func Example_createPaymentBatch() {
 	key, err := CreateNewKeyPair()
 	if err != nil {
 		panic(err)
@@ -52,6 +58,33 @@ func TestDraftPaymentCreate(t *testing.T) {
 	assert.NotZero(t, res.Response[0].ID.ID)
 }
 
+func TestCreatePayment(t *testing.T) {
+	t.Parallel()
+
+	c, fakeServer, cancel := createClientWithFakeServer(t)
+	defer cancel()
+	defer fakeServer.Close()
+
+	assert.NoError(t, c.Init())
+
+	create := PaymentCreate{
+		Amount:            Amount{Currency: "EUR", Value: "0.01"},
+		CounterpartyAlias: Pointer{PType: "EMAIL", Value: "bravo@bunq.com"},
+		Description:       "test",
+	}
+
+	res, err := c.PaymentService.CreatePayment(10111, create)
+
+	assert.NoError(t, err)
+	assert.Equal(t, 6292, res.Response[0].ID.ID)
+
+	payment, err := c.PaymentService.CreatePaymentAndGet(10111, create)
+
+	if assert.NoError(t, err) {
+		assert.NotZero(t, payment.ID)
+	}
+}
+
 func TestGetDraftPayment(t *testing.T) {
 	t.Parallel()
 
@@ -96,12 +129,12 @@ func TestDraftPaymentUpdate(t *testing.T) {
 	_, err = c.PaymentService.UpdateDraftPayment(
 		res.Response[0].ID.ID,
 		9618,
-		requestUpdateDraftPayment{
-			requestCreateDraftPayment: requestCreateDraftPayment{
-				Entries: append(allDraftPaymentEntry, draftPaymentEntryCreate{
+		DraftPaymentUpdate{
+			DraftPaymentCreate: DraftPaymentCreate{
+				Entries: append(allDraftPaymentEntry, DraftPaymentEntryCreate{
 					Amount: Amount{
 						Currency: "EUR",
-						Value:    "1",
+						Value:    "1.00",
 					},
 					CounterpartyAlias: Pointer{
 						PType: "EMAIL",
@@ -120,16 +153,90 @@ func TestDraftPaymentUpdate(t *testing.T) {
 	assert.NoError(t, err)
 }
 
+func TestDraftPaymentStatus(t *testing.T) {
+	t.Parallel()
+
+	var body []byte
+	var mu sync.Mutex
+
+	handler := createBunqFakeHandler(t)
+	fakeServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodPut {
+			mu.Lock()
+			body, _ = ioutil.ReadAll(r.Body)
+			mu.Unlock()
+		}
+
+		handler(w, r)
+	}))
+	defer fakeServer.Close()
+
+	key, err := CreateNewKeyPair()
+	assert.NoError(t, err)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	c := NewClient(ctx, fmt.Sprintf("%s/v1/", fakeServer.URL), key, "", "")
+	assert.NoError(t, c.Init())
+
+	_, err = c.PaymentService.AcceptDraftPayment(6292, 9618)
+	assert.NoError(t, err)
+
+	mu.Lock()
+	assert.JSONEq(t, `{"previous_updated_timestamp":"2018-11-30 20:51:37.639339","status":"ACCEPTED"}`, string(body))
+	mu.Unlock()
+
+	_, err = c.PaymentService.RejectDraftPayment(6292, 9618)
+	assert.NoError(t, err)
+
+	_, err = c.PaymentService.CancelDraftPaymentCtx(context.Background(), 6292, 9618)
+	assert.NoError(t, err)
+}
+
+func TestDraftPaymentEntryCreateJSON(t *testing.T) {
+	t.Parallel()
+
+	bodyRaw, err := json.Marshal(DraftPaymentEntryCreate{
+		Amount:            Amount{Value: "12.50", Currency: "EUR"},
+		CounterpartyAlias: Pointer{PType: "EMAIL", Value: "bravo@bunq.com"},
+	})
+
+	assert.NoError(t, err)
+	assert.JSONEq(t, `{"amount":{"value":"12.50","currency":"EUR"},"counterparty_alias":{"type":"EMAIL","value":"bravo@bunq.com"}}`, string(bodyRaw))
+}
+
+func TestIterateDraftPayments(t *testing.T) {
+	t.Parallel()
+
+	c, fakeServer, cancel := createClientWithFakeServer(t)
+	defer cancel()
+	defer fakeServer.Close()
+
+	assert.NoError(t, c.Init())
+
+	it := c.PaymentService.IterateDraftPayments(9618, PageOptions{})
+
+	var drafts []DraftPayment
+	for it.Next(context.Background()) {
+		drafts = append(drafts, it.Item())
+	}
+
+	if assert.NoError(t, it.Err()) && assert.Len(t, drafts, 1) {
+		assert.Equal(t, DraftPaymentStatusPending, drafts[0].Status)
+	}
+}
+
 func createNewDraftPayment(c *Client) (*responseBunqID, error) {
 	i := 1
 	return c.PaymentService.CreateDraftPayment(
 		9618,
-		requestCreateDraftPayment{
-			Entries: []draftPaymentEntryCreate{
+		DraftPaymentCreate{
+			Entries: []DraftPaymentEntryCreate{
 				{
 					Amount: Amount{
 						Currency: "EUR",
-						Value:    "1",
+						Value:    "1.00",
 					},
 					CounterpartyAlias: Pointer{
 						PType: "EMAIL",
@@ -166,11 +273,11 @@ func generateBatchEntries(nr int) []PaymentCreate {
 	return entries
 }
 
-func convertDraftPaymentEntryToCreateEntry(allEntry ...draftPaymentEntry) []draftPaymentEntryCreate {
-	var allCreateEntry []draftPaymentEntryCreate
+func convertDraftPaymentEntryToCreateEntry(allEntry ...DraftPaymentEntry) []DraftPaymentEntryCreate {
+	var allCreateEntry []DraftPaymentEntryCreate
 
 	for _, entry := range allEntry {
-		allCreateEntry = append(allCreateEntry, draftPaymentEntryCreate{
+		allCreateEntry = append(allCreateEntry, DraftPaymentEntryCreate{
 			Amount: entry.Amount,
 			CounterpartyAlias: Pointer{
 				PType: "IBAN",
@@ -319,3 +426,28 @@ func Test_paymentService_GetPayment(t *testing.T) {
 		})
 	}
 }
+
+func Test_paymentService_SyncPayments(t *testing.T) {
+	t.Parallel()
+
+	c, fakeServer, cancel := createClientWithFakeServer(t)
+	defer cancel()
+	defer fakeServer.Close()
+	if !assert.NoError(t, c.Init()) {
+		return
+	}
+
+	first, err := c.PaymentService.SyncPayments(10111, "")
+	if !assert.NoError(t, err) {
+		return
+	}
+
+	assert.Len(t, first.Payments, 2)
+	assert.Equal(t, "/v1/user/7082/monetary-account/10111/payment?newer_id=261172", first.Cursor)
+
+	next, err := c.PaymentService.SyncPayments(10111, first.Cursor)
+	if assert.NoError(t, err) {
+		assert.NotEmpty(t, next.Payments)
+		assert.Equal(t, first.Cursor, next.Cursor)
+	}
+}
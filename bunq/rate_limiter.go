@@ -0,0 +1,192 @@
+package bunq
+
+import (
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	rateLimitClassGet           string = "GET"
+	rateLimitClassPost          string = "POST"
+	rateLimitClassPut           string = "PUT"
+	rateLimitClassSessionServer string = "SESSION-SERVER"
+)
+
+// RateLimiter decides when a request is allowed to be sent to bunq.
+type RateLimiter interface {
+	// Wait blocks until r may be sent. It returns early with an error when the context of r is done.
+	Wait(r *http.Request) error
+}
+
+// RateLimit is the amount of requests that are allowed within a period.
+type RateLimit struct {
+	Requests int
+	Period   time.Duration
+}
+
+// TokenBucketRateLimiter is a RateLimiter that keeps a token bucket per method class and endpoint family.
+// A token is handed back one period after it has been used, so there are never more than
+// RateLimit.Requests requests within any window of RateLimit.Period, which is how bunq counts.
+type TokenBucketRateLimiter struct {
+	mu      sync.Mutex
+	limits  map[string]RateLimit
+	buckets map[string]*tokenBucket
+}
+
+// NewTokenBucketRateLimiter creates a rate limiter with the limits documented by bunq:
+// 3 GET, 5 POST and 2 PUT requests per 3 seconds and 1 session-server call per 30 seconds.
+func NewTokenBucketRateLimiter() *TokenBucketRateLimiter {
+	return &TokenBucketRateLimiter{
+		limits: map[string]RateLimit{
+			rateLimitClassGet:           {Requests: 3, Period: time.Second * 3},
+			rateLimitClassPost:          {Requests: 5, Period: time.Second * 3},
+			rateLimitClassPut:           {Requests: 2, Period: time.Second * 3},
+			rateLimitClassSessionServer: {Requests: 1, Period: time.Second * 30},
+		},
+		buckets: make(map[string]*tokenBucket),
+	}
+}
+
+// SetLimit overrides the limit of a method class, one of GET, POST, PUT or SESSION-SERVER.
+func (l *TokenBucketRateLimiter) SetLimit(class string, limit RateLimit) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	l.limits[class] = limit
+
+	for key := range l.buckets {
+		if strings.HasPrefix(key, class+" ") {
+			delete(l.buckets, key)
+		}
+	}
+}
+
+// Wait implements RateLimiter. When the context of r is done before the token may be used, the token is handed
+// back so a cancelled request does not delay the requests after it.
+func (l *TokenBucketRateLimiter) Wait(r *http.Request) error {
+	res := l.reserve(r, time.Now())
+	if res.delay <= 0 {
+		return nil
+	}
+
+	err := sleepCtx(r.Context(), res.delay)
+	if err != nil {
+		l.mu.Lock()
+		res.cancel()
+		l.mu.Unlock()
+	}
+
+	return err
+}
+
+func (l *TokenBucketRateLimiter) reserve(r *http.Request, now time.Time) tokenReservation {
+	class := rateLimitClass(r)
+	key := class + " " + endpointFamily(r.URL.Path)
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	limit, ok := l.limits[class]
+	if !ok || limit.Requests < 1 {
+		return tokenReservation{}
+	}
+
+	b, ok := l.buckets[key]
+	if !ok {
+		b = newTokenBucket(limit)
+		l.buckets[key] = b
+	}
+
+	return b.reserve(now)
+}
+
+func rateLimitClass(r *http.Request) string {
+	if strings.HasSuffix(strings.TrimSuffix(r.URL.Path, "/"), endpointSessionServerCreate) {
+		return rateLimitClassSessionServer
+	}
+
+	switch r.Method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions:
+		return rateLimitClassGet
+	case http.MethodPost:
+		return rateLimitClassPost
+	default:
+		return rateLimitClassPut
+	}
+}
+
+// endpointFamily strips the ids from a path so that e.g. all payments of all accounts share one bucket.
+func endpointFamily(path string) string {
+	parts := strings.Split(strings.Trim(path, "/"), "/")
+
+	for i, p := range parts {
+		if _, err := strconv.Atoi(p); err == nil {
+			parts[i] = "{id}"
+		}
+	}
+
+	return strings.Join(parts, "/")
+}
+
+type tokenBucket struct {
+	period time.Duration
+	// availableAt holds per token the time it can be used again.
+	availableAt []time.Time
+}
+
+func newTokenBucket(limit RateLimit) *tokenBucket {
+	return &tokenBucket{
+		period:      limit.Period,
+		availableAt: make([]time.Time, limit.Requests),
+	}
+}
+
+// tokenReservation is a token taken from a bucket that may only be used after delay.
+type tokenReservation struct {
+	bucket *tokenBucket
+	index  int
+	// previous is the time the token was available at before it was taken, usedUntil the time it is taken until.
+	previous  time.Time
+	usedUntil time.Time
+	delay     time.Duration
+}
+
+// cancel hands the token back to the bucket, unless it has been taken again since. The lock of the rate limiter
+// must be held.
+func (r tokenReservation) cancel() {
+	if r.bucket == nil || !r.bucket.availableAt[r.index].Equal(r.usedUntil) {
+		return
+	}
+
+	r.bucket.availableAt[r.index] = r.previous
+}
+
+// reserve takes the token that is available first and returns how long to wait before it may be used.
+func (b *tokenBucket) reserve(now time.Time) tokenReservation {
+	first := 0
+	for i, t := range b.availableAt {
+		if t.Before(b.availableAt[first]) {
+			first = i
+		}
+	}
+
+	start := b.availableAt[first]
+	if start.Before(now) {
+		start = now
+	}
+
+	res := tokenReservation{
+		bucket:    b,
+		index:     first,
+		previous:  b.availableAt[first],
+		usedUntil: start.Add(b.period),
+		delay:     start.Sub(now),
+	}
+
+	b.availableAt[first] = res.usedUntil
+
+	return res
+}
@@ -0,0 +1,83 @@
+package bunq
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTokenBucketRateLimiter_reserve(t *testing.T) {
+	t.Parallel()
+
+	l := NewTokenBucketRateLimiter()
+	now := time.Now()
+
+	get, _ := http.NewRequest(http.MethodGet, BaseURLSandbox+"user/1/monetary-account/2/payment", nil)
+	otherGet, _ := http.NewRequest(http.MethodGet, BaseURLSandbox+"user/1/monetary-account/3/payment", nil)
+
+	// Both requests belong to the same endpoint family and share a bucket.
+	assert.Zero(t, l.reserve(get, now).delay)
+	assert.Zero(t, l.reserve(otherGet, now).delay)
+	assert.Zero(t, l.reserve(get, now.Add(time.Second)).delay)
+	assert.Equal(t, time.Second*3, l.reserve(get, now).delay)
+	assert.Equal(t, time.Second*2, l.reserve(get, now.Add(time.Second)).delay)
+
+	put, _ := http.NewRequest(http.MethodPut, BaseURLSandbox+"user/1/monetary-account/2/payment", nil)
+
+	assert.Zero(t, l.reserve(put, now).delay)
+	assert.Zero(t, l.reserve(put, now).delay)
+	assert.Equal(t, time.Second*3, l.reserve(put, now).delay)
+
+	session, _ := http.NewRequest(http.MethodPost, BaseURLSandbox+endpointSessionServerCreate, nil)
+
+	assert.Zero(t, l.reserve(session, now).delay)
+	assert.Equal(t, time.Second*30, l.reserve(session, now).delay)
+}
+
+func TestTokenBucketRateLimiter_SetLimit(t *testing.T) {
+	t.Parallel()
+
+	l := NewTokenBucketRateLimiter()
+	l.SetLimit(rateLimitClassPost, RateLimit{Requests: 1, Period: time.Minute})
+
+	now := time.Now()
+	post, _ := http.NewRequest(http.MethodPost, BaseURLSandbox+"user/1/monetary-account/2/payment-batch", nil)
+
+	assert.Zero(t, l.reserve(post, now).delay)
+	assert.Equal(t, time.Minute, l.reserve(post, now).delay)
+}
+
+func TestTokenBucketRateLimiter_WaitCancelled(t *testing.T) {
+	t.Parallel()
+
+	l := NewTokenBucketRateLimiter()
+	l.SetLimit(rateLimitClassPost, RateLimit{Requests: 1, Period: time.Second})
+
+	url := BaseURLSandbox + "user/1/monetary-account/2/payment"
+
+	first, _ := http.NewRequest(http.MethodPost, url, nil)
+	assert.NoError(t, l.Wait(first))
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Millisecond*50)
+	defer cancel()
+
+	cancelled, _ := http.NewRequestWithContext(ctx, http.MethodPost, url, nil)
+	assert.Equal(t, context.DeadlineExceeded, l.Wait(cancelled))
+
+	// The token of the cancelled request is handed back, the next request only waits for the first one.
+	next, _ := http.NewRequest(http.MethodPost, url, nil)
+
+	start := time.Now()
+	assert.NoError(t, l.Wait(next))
+	assert.True(t, time.Since(start) < time.Second, "waited %s", time.Since(start))
+}
+
+func TestEndpointFamily(t *testing.T) {
+	t.Parallel()
+
+	assert.Equal(t, "v1/user/{id}/monetary-account/{id}/payment", endpointFamily("/v1/user/6084/monetary-account/10111/payment"))
+	assert.Equal(t, "v1/session-server", endpointFamily("/v1/session-server"))
+}
@@ -1,5 +1,7 @@
 package bunq
 
+import "github.com/pkg/errors"
+
 type requestInstallation struct {
 	ClientPublicKey string `json:"client_public_key"`
 }
@@ -14,23 +16,54 @@ type requestSessionServer struct {
 	Secret string `json:"secret"`
 }
 
-type requestUserPersonPut struct {
-	NotificationFilters []notificationFilter `json:"notification_filters,omitempty"`
+// UserPersonUpdate The changes to the user person.
+type UserPersonUpdate struct {
+	NotificationFilters []NotificationFilter `json:"notification_filters,omitempty"`
+}
+
+// UserCompanyUpdate The changes to the user company. Only the fields that are set are changed.
+type UserCompanyUpdate struct {
+	Name                               *string              `json:"name,omitempty"`
+	PublicNickName                     *string              `json:"public_nick_name,omitempty"`
+	AddressMain                        *Address             `json:"address_main,omitempty"`
+	AddressPostal                      *Address             `json:"address_postal,omitempty"`
+	Language                           *string              `json:"language,omitempty"`
+	Region                             *string              `json:"region,omitempty"`
+	Country                            *string              `json:"country,omitempty"`
+	UBO                                []UBO                `json:"ubo,omitempty"`
+	ChamberOfCommerceNumber            *string              `json:"chamber_of_commerce_number,omitempty"`
+	SessionTimeout                     *int64               `json:"session_timeout,omitempty"`
+	DailyLimitWithoutConfirmationLogin *Amount              `json:"daily_limit_without_confirmation_login,omitempty"`
+	NotificationFilters                []NotificationFilter `json:"notification_filters,omitempty"`
+}
+
+// NotificationFilterURLCreate A callback url to notify about events of a category.
+type NotificationFilterURLCreate struct {
+	Category           NotificationCategory `json:"category"`
+	NotificationTarget string               `json:"notification_target"`
+}
+
+type requestNotificationFilterURL struct {
+	NotificationFilters []NotificationFilterURLCreate `json:"notification_filters"`
 }
 
-type requestCreateDraftPayment struct {
-	Entries                 []draftPaymentEntryCreate `json:"entries"`
+// DraftPaymentCreate A draft payment to create. The entries are paid once the draft payment is accepted.
+type DraftPaymentCreate struct {
+	Entries                 []DraftPaymentEntryCreate `json:"entries,omitempty"`
 	NumberOfRequiredAccepts *int                      `json:"number_of_required_accepts,omitempty"`
 }
 
-type requestUpdateDraftPayment struct {
-	requestCreateDraftPayment
-	UpdatedTimestamp string `json:"previous_updated_timestamp"`
-	status           *string
+// DraftPaymentUpdate The changes to a draft payment. UpdatedTimestamp must be the Updated field of the
+// draft payment as last fetched, bunq rejects the update when the draft payment changed in the meantime.
+type DraftPaymentUpdate struct {
+	DraftPaymentCreate
+	UpdatedTimestamp string              `json:"previous_updated_timestamp"`
+	Status           *DraftPaymentStatus `json:"status,omitempty"`
 }
 
-type draftPaymentEntryCreate struct {
-	Amount            Amount  `json:"Amount,omitempty"`
+// DraftPaymentEntryCreate A single payment of a draft payment.
+type DraftPaymentEntryCreate struct {
+	Amount            Amount  `json:"amount"`
 	CounterpartyAlias Pointer `json:"counterparty_alias,omitempty"`
 	Description       string  `json:"description,omitempty"`
 	MerchantReference *string `json:"merchant_reference,omitempty"`
@@ -40,9 +73,313 @@ type PaymentBatchCreate struct {
 	Payments []PaymentCreate `json:"payments"`
 }
 
+// PaymentCreate A payment to create, on its own or as part of a PaymentBatchCreate.
 type PaymentCreate struct {
 	Amount            Amount  `json:"amount"`
 	CounterpartyAlias Pointer `json:"counterparty_alias"`
 	Description       string  `json:"description"`
 	AllowBunqto       bool    `json:"allow_bunqto"`
+	MerchantReference *string `json:"merchant_reference,omitempty"`
+}
+
+// CardUpdate The fields of a card that can be changed. Only the set fields are sent. Pin and activation codes
+// can not be set, bunq only accepts those in an encrypted request body.
+type CardUpdate struct {
+	Status                    *CardStatus             `json:"status,omitempty"`
+	CardLimit                 *Amount                 `json:"card_limit,omitempty"`
+	CardLimitAtm              *Amount                 `json:"card_limit_atm,omitempty"`
+	CountryPermission         []CardCountryPermission `json:"country_permission,omitempty"`
+	PinCodeAssignment         []CardPinCodeAssignment `json:"pin_code_assignment,omitempty"`
+	MonetaryAccountIDFallback *int                    `json:"monetary_account_id_fallback,omitempty"`
+}
+
+// CardReplace The request to order a replacement for a card. The replacement keeps the pin code of the card.
+type CardReplace struct {
+	NameOnCard        string                  `json:"name_on_card,omitempty"`
+	SecondLine        string                  `json:"second_line,omitempty"`
+	PinCodeAssignment []CardPinCodeAssignment `json:"pin_code_assignment,omitempty"`
+}
+
+// RequestInquiryCreate A request inquiry to send.
+type RequestInquiryCreate struct {
+	AmountInquired    Amount  `json:"amount_inquired"`
+	CounterpartyAlias Pointer `json:"counterparty_alias"`
+	Description       string  `json:"description"`
+	AllowBunqme       bool    `json:"allow_bunqme"`
+	MerchantReference *string `json:"merchant_reference,omitempty"`
+	MinimumAge        *int    `json:"minimum_age,omitempty"`
+	RequireAddress    *string `json:"require_address,omitempty"`
+	RedirectURL       *string `json:"redirect_url,omitempty"`
+	EventID           *int    `json:"event_id,omitempty"`
+}
+
+// RequestInquiryBatchCreate A number of request inquiries to send together.
+type RequestInquiryBatchCreate struct {
+	RequestInquiries    []RequestInquiryCreate `json:"request_inquiries"`
+	TotalAmountInquired Amount                 `json:"total_amount_inquired"`
+	EventID             *int                   `json:"event_id,omitempty"`
+}
+
+type requestRequestInquiryRevoke struct {
+	Status RequestInquiryStatus `json:"status"`
+}
+
+type requestRequestResponseUpdate struct {
+	AmountResponded *Amount               `json:"amount_responded,omitempty"`
+	Status          RequestResponseStatus `json:"status"`
+	AddressShipping *Address              `json:"address_shipping,omitempty"`
+	AddressBilling  *Address              `json:"address_billing,omitempty"`
+}
+
+// ScheduledPaymentCreate A payment to schedule, also used to update a scheduled payment.
+type ScheduledPaymentCreate struct {
+	Payment  PaymentCreate `json:"payment"`
+	Schedule Schedule      `json:"schedule"`
+}
+
+// ScheduledPaymentBatchCreate A number of payments to schedule together, also used to update a scheduled payment batch.
+type ScheduledPaymentBatchCreate struct {
+	Payments []PaymentCreate `json:"payments"`
+	Schedule Schedule        `json:"schedule"`
+}
+
+// MonetaryAccountBankCreate A bank account to create. Only the set fields are sent.
+type MonetaryAccountBankCreate struct {
+	Currency    string                  `json:"currency,omitempty"`
+	Description string                  `json:"description,omitempty"`
+	DailyLimit  *Amount                 `json:"daily_limit,omitempty"`
+	AvatarUUID  *string                 `json:"avatar_uuid,omitempty"`
+	Setting     *MonetaryAccountSetting `json:"setting,omitempty"`
+}
+
+// MonetaryAccountBankUpdate The fields of a bank account that can be changed. Only the set fields are sent.
+type MonetaryAccountBankUpdate struct {
+	Description string                  `json:"description,omitempty"`
+	DailyLimit  *Amount                 `json:"daily_limit,omitempty"`
+	AvatarUUID  *string                 `json:"avatar_uuid,omitempty"`
+	Setting     *MonetaryAccountSetting `json:"setting,omitempty"`
+}
+
+// MonetaryAccountSavingCreate A savings account to create. Only the set fields are sent.
+type MonetaryAccountSavingCreate struct {
+	Currency    string                  `json:"currency,omitempty"`
+	Description string                  `json:"description,omitempty"`
+	DailyLimit  *Amount                 `json:"daily_limit,omitempty"`
+	AvatarUUID  *string                 `json:"avatar_uuid,omitempty"`
+	Setting     *MonetaryAccountSetting `json:"setting,omitempty"`
+	SavingsGoal *Amount                 `json:"savings_goal,omitempty"`
+}
+
+// MonetaryAccountSavingUpdate The fields of a savings account that can be changed. Only the set fields are sent.
+type MonetaryAccountSavingUpdate struct {
+	Description string                  `json:"description,omitempty"`
+	DailyLimit  *Amount                 `json:"daily_limit,omitempty"`
+	AvatarUUID  *string                 `json:"avatar_uuid,omitempty"`
+	Setting     *MonetaryAccountSetting `json:"setting,omitempty"`
+	SavingsGoal *Amount                 `json:"savings_goal,omitempty"`
+}
+
+// MonetaryAccountJointCreate A joint account to create. Only the set fields are sent.
+type MonetaryAccountJointCreate struct {
+	Currency    string                  `json:"currency,omitempty"`
+	Description string                  `json:"description,omitempty"`
+	DailyLimit  *Amount                 `json:"daily_limit,omitempty"`
+	AvatarUUID  *string                 `json:"avatar_uuid,omitempty"`
+	Setting     *MonetaryAccountSetting `json:"setting,omitempty"`
+	AllCoOwner  []CoOwnerCreate         `json:"all_co_owner,omitempty"`
+}
+
+// MonetaryAccountJointUpdate The fields of a joint account that can be changed. Only the set fields are sent,
+// the co-owners can not be changed.
+type MonetaryAccountJointUpdate struct {
+	Description string                  `json:"description,omitempty"`
+	DailyLimit  *Amount                 `json:"daily_limit,omitempty"`
+	AvatarUUID  *string                 `json:"avatar_uuid,omitempty"`
+	Setting     *MonetaryAccountSetting `json:"setting,omitempty"`
+}
+
+// CoOwnerCreate A user to invite as co-owner of a joint account.
+type CoOwnerCreate struct {
+	Alias Pointer `json:"alias"`
+}
+
+// MonetaryAccountSubStatus The sub-status of a monetary account, it explains the status.
+type MonetaryAccountSubStatus string
+
+// The sub-statuses of a cancelled monetary account.
+const (
+	MonetaryAccountSubStatusRedemptionVoluntary MonetaryAccountSubStatus = "REDEMPTION_VOLUNTARY"
+)
+
+// MonetaryAccountCloseReason The reason a monetary account is closed for.
+type MonetaryAccountCloseReason string
+
+// The reasons to close a monetary account, bunq only accepts OTHER for now.
+const (
+	MonetaryAccountCloseReasonOther MonetaryAccountCloseReason = "OTHER"
+)
+
+// MonetaryAccountClose Why a monetary account is closed. The account is closed by moving it to the CANCELLED status.
+type MonetaryAccountClose struct {
+	SubStatus         MonetaryAccountSubStatus   `json:"sub_status"`
+	Reason            MonetaryAccountCloseReason `json:"reason"`
+	ReasonDescription string                     `json:"reason_description"`
+}
+
+type requestMonetaryAccountClose struct {
+	Status MonetaryAccountStatus `json:"status"`
+	MonetaryAccountClose
+}
+
+func newRequestMonetaryAccountClose(accountClose MonetaryAccountClose) requestMonetaryAccountClose {
+	return requestMonetaryAccountClose{
+		Status:               MonetaryAccountStatusCancelled,
+		MonetaryAccountClose: accountClose,
+	}
+}
+
+func (m MonetaryAccountClose) validate() error {
+	if m.SubStatus == "" || m.Reason == "" {
+		return errors.New("bunq: closing a monetary account requires a sub-status and a reason")
+	}
+
+	return nil
+}
+
+func (m MonetaryAccountBankCreate) validate() error {
+	return validateDailyLimit(m.DailyLimit)
+}
+
+func (m MonetaryAccountBankUpdate) validate() error {
+	return validateDailyLimit(m.DailyLimit)
+}
+
+func (m MonetaryAccountSavingCreate) validate() error {
+	err := validateDailyLimit(m.DailyLimit)
+	if err != nil {
+		return err
+	}
+
+	return validateSavingsGoal(m.SavingsGoal)
+}
+
+func (m MonetaryAccountSavingUpdate) validate() error {
+	err := validateDailyLimit(m.DailyLimit)
+	if err != nil {
+		return err
+	}
+
+	return validateSavingsGoal(m.SavingsGoal)
+}
+
+func (m MonetaryAccountJointCreate) validate() error {
+	return validateDailyLimit(m.DailyLimit)
+}
+
+func (m MonetaryAccountJointUpdate) validate() error {
+	return validateDailyLimit(m.DailyLimit)
+}
+
+func validateDailyLimit(limit *Amount) error {
+	if limit == nil {
+		return nil
+	}
+
+	return errors.Wrap(limit.Validate(), "bunq: invalid daily limit")
+}
+
+func validateSavingsGoal(goal *Amount) error {
+	if goal == nil {
+		return nil
+	}
+
+	return errors.Wrap(goal.Validate(), "bunq: invalid savings goal")
+}
+
+func (u UserCompanyUpdate) validate() error {
+	if u.DailyLimitWithoutConfirmationLogin != nil {
+		err := u.DailyLimitWithoutConfirmationLogin.Validate()
+		if err != nil {
+			return errors.Wrap(err, "bunq: invalid daily limit without confirmation login")
+		}
+	}
+
+	return nil
+}
+
+func (c CardUpdate) validate() error {
+	if c.CardLimit != nil {
+		err := c.CardLimit.Validate()
+		if err != nil {
+			return errors.Wrap(err, "bunq: invalid card limit")
+		}
+	}
+
+	if c.CardLimitAtm != nil {
+		err := c.CardLimitAtm.Validate()
+		if err != nil {
+			return errors.Wrap(err, "bunq: invalid atm card limit")
+		}
+	}
+
+	return nil
+}
+
+func (p PaymentCreate) validate() error {
+	return errors.Wrap(p.Amount.validatePositive(), "bunq: invalid payment")
+}
+
+func (b PaymentBatchCreate) validate() error {
+	for _, p := range b.Payments {
+		err := p.validate()
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (d DraftPaymentCreate) validate() error {
+	for _, e := range d.Entries {
+		err := e.Amount.validatePositive()
+		if err != nil {
+			return errors.Wrap(err, "bunq: invalid draft payment entry")
+		}
+	}
+
+	return nil
+}
+
+func (s ScheduledPaymentCreate) validate() error {
+	err := s.Payment.validate()
+	if err != nil {
+		return err
+	}
+
+	return s.Schedule.validate()
+}
+
+func (s ScheduledPaymentBatchCreate) validate() error {
+	err := PaymentBatchCreate{Payments: s.Payments}.validate()
+	if err != nil {
+		return err
+	}
+
+	return s.Schedule.validate()
+}
+
+func (r RequestInquiryCreate) validate() error {
+	return errors.Wrap(r.AmountInquired.validatePositive(), "bunq: invalid request inquiry")
+}
+
+func (b RequestInquiryBatchCreate) validate() error {
+	for _, r := range b.RequestInquiries {
+		err := r.validate()
+		if err != nil {
+			return err
+		}
+	}
+
+	return errors.Wrap(b.TotalAmountInquired.validatePositive(), "bunq: invalid request inquiry batch")
 }
@@ -0,0 +1,170 @@
+package bunq
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/pkg/errors"
+)
+
+type requestInquiryService service
+
+// CreateRequestInquiry sends a payment request from the given account.
+func (r *requestInquiryService) CreateRequestInquiry(monetaryAccountID int, create RequestInquiryCreate) (*responseBunqID, error) {
+	return r.CreateRequestInquiryCtx(context.Background(), monetaryAccountID, create)
+}
+
+// CreateRequestInquiryCtx is CreateRequestInquiry with a context for the request.
+func (r *requestInquiryService) CreateRequestInquiryCtx(ctx context.Context, monetaryAccountID int, create RequestInquiryCreate) (*responseBunqID, error) {
+	userID, err := r.client.GetUserID()
+	if err != nil {
+		return nil, err
+	}
+
+	err = create.validate()
+	if err != nil {
+		return nil, err
+	}
+
+	bodyRaw, err := json.Marshal(create)
+	if err != nil {
+		return nil, errors.Wrap(err, "bunq: could not marshal body")
+	}
+
+	return r.client.doCURequest(ctx, r.client.formatRequestURL(fmt.Sprintf(endpointRequestInquiryListing, userID, monetaryAccountID)), bodyRaw, http.MethodPost)
+}
+
+// GetRequestInquiry returns a specific request inquiry for a given account.
+func (r *requestInquiryService) GetRequestInquiry(monetaryAccountID, id int) (*ResponseRequestInquiryGet, error) {
+	return r.GetRequestInquiryCtx(context.Background(), monetaryAccountID, id)
+}
+
+// GetRequestInquiryCtx is GetRequestInquiry with a context for the request.
+func (r *requestInquiryService) GetRequestInquiryCtx(ctx context.Context, monetaryAccountID, id int) (*ResponseRequestInquiryGet, error) {
+	userID, err := r.client.GetUserID()
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := r.client.preformRequest(ctx, http.MethodGet, r.client.formatRequestURL(fmt.Sprintf(endpointRequestInquiryWithID, userID, monetaryAccountID, id)), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var resStruct ResponseRequestInquiryGet
+
+	return &resStruct, r.client.parseResponse(res, &resStruct)
+}
+
+// IterateRequestInquiries returns an iterator over the request inquiries of the given account.
+func (r *requestInquiryService) IterateRequestInquiries(monetaryAccountID int, opts PageOptions) *RequestInquiryIterator {
+	userID, err := r.client.GetUserID()
+	if err != nil {
+		return &RequestInquiryIterator{it: newPageIteratorFromError(err)}
+	}
+
+	return &RequestInquiryIterator{
+		it: newPageIterator(r.client, "RequestInquiry", fmt.Sprintf(endpointRequestInquiryListing, userID, monetaryAccountID), opts),
+	}
+}
+
+// RevokeRequestInquiry revokes a pending request inquiry.
+func (r *requestInquiryService) RevokeRequestInquiry(monetaryAccountID, id int) (*responseBunqID, error) {
+	return r.RevokeRequestInquiryCtx(context.Background(), monetaryAccountID, id)
+}
+
+// RevokeRequestInquiryCtx is RevokeRequestInquiry with a context for the request.
+func (r *requestInquiryService) RevokeRequestInquiryCtx(ctx context.Context, monetaryAccountID, id int) (*responseBunqID, error) {
+	userID, err := r.client.GetUserID()
+	if err != nil {
+		return nil, err
+	}
+
+	bodyRaw, err := json.Marshal(requestRequestInquiryRevoke{Status: RequestInquiryStatusRevoked})
+	if err != nil {
+		return nil, errors.Wrap(err, "bunq: could not marshal body")
+	}
+
+	return r.client.doCURequest(ctx, r.client.formatRequestURL(fmt.Sprintf(endpointRequestInquiryWithID, userID, monetaryAccountID, id)), bodyRaw, http.MethodPut)
+}
+
+// CreateRequestInquiryBatch sends a number of payment requests from the given account at once.
+func (r *requestInquiryService) CreateRequestInquiryBatch(monetaryAccountID int, create RequestInquiryBatchCreate) (*responseBunqID, error) {
+	return r.CreateRequestInquiryBatchCtx(context.Background(), monetaryAccountID, create)
+}
+
+// CreateRequestInquiryBatchCtx is CreateRequestInquiryBatch with a context for the request.
+func (r *requestInquiryService) CreateRequestInquiryBatchCtx(ctx context.Context, monetaryAccountID int, create RequestInquiryBatchCreate) (*responseBunqID, error) {
+	userID, err := r.client.GetUserID()
+	if err != nil {
+		return nil, err
+	}
+
+	err = create.validate()
+	if err != nil {
+		return nil, err
+	}
+
+	bodyRaw, err := json.Marshal(create)
+	if err != nil {
+		return nil, errors.Wrap(err, "bunq: could not marshal body")
+	}
+
+	return r.client.doCURequest(ctx, r.client.formatRequestURL(fmt.Sprintf(endpointRequestInquiryBatchListing, userID, monetaryAccountID)), bodyRaw, http.MethodPost)
+}
+
+// GetRequestInquiryBatch returns a specific request inquiry batch for a given account.
+func (r *requestInquiryService) GetRequestInquiryBatch(monetaryAccountID, id int) (*ResponseRequestInquiryBatchGet, error) {
+	return r.GetRequestInquiryBatchCtx(context.Background(), monetaryAccountID, id)
+}
+
+// GetRequestInquiryBatchCtx is GetRequestInquiryBatch with a context for the request.
+func (r *requestInquiryService) GetRequestInquiryBatchCtx(ctx context.Context, monetaryAccountID, id int) (*ResponseRequestInquiryBatchGet, error) {
+	userID, err := r.client.GetUserID()
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := r.client.preformRequest(ctx, http.MethodGet, r.client.formatRequestURL(fmt.Sprintf(endpointRequestInquiryBatchWithID, userID, monetaryAccountID, id)), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var resStruct ResponseRequestInquiryBatchGet
+
+	return &resStruct, r.client.parseResponse(res, &resStruct)
+}
+
+// IterateRequestInquiryBatches returns an iterator over the request inquiry batches of the given account.
+func (r *requestInquiryService) IterateRequestInquiryBatches(monetaryAccountID int, opts PageOptions) *RequestInquiryBatchIterator {
+	userID, err := r.client.GetUserID()
+	if err != nil {
+		return &RequestInquiryBatchIterator{it: newPageIteratorFromError(err)}
+	}
+
+	return &RequestInquiryBatchIterator{
+		it: newPageIterator(r.client, "RequestInquiryBatch", fmt.Sprintf(endpointRequestInquiryBatchListing, userID, monetaryAccountID), opts),
+	}
+}
+
+// RevokeRequestInquiryBatch revokes all pending request inquiries of the batch.
+func (r *requestInquiryService) RevokeRequestInquiryBatch(monetaryAccountID, id int) (*responseBunqID, error) {
+	return r.RevokeRequestInquiryBatchCtx(context.Background(), monetaryAccountID, id)
+}
+
+// RevokeRequestInquiryBatchCtx is RevokeRequestInquiryBatch with a context for the request.
+func (r *requestInquiryService) RevokeRequestInquiryBatchCtx(ctx context.Context, monetaryAccountID, id int) (*responseBunqID, error) {
+	userID, err := r.client.GetUserID()
+	if err != nil {
+		return nil, err
+	}
+
+	bodyRaw, err := json.Marshal(requestRequestInquiryRevoke{Status: RequestInquiryStatusRevoked})
+	if err != nil {
+		return nil, errors.Wrap(err, "bunq: could not marshal body")
+	}
+
+	return r.client.doCURequest(ctx, r.client.formatRequestURL(fmt.Sprintf(endpointRequestInquiryBatchWithID, userID, monetaryAccountID, id)), bodyRaw, http.MethodPut)
+}
@@ -0,0 +1,103 @@
+package bunq
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRequestInquiryService_RequestInquiry(t *testing.T) {
+	t.Parallel()
+
+	c, fakeServer, cancel := createClientWithFakeServer(t)
+	defer cancel()
+	defer fakeServer.Close()
+
+	assert.NoError(t, c.Init())
+
+	created, err := c.RequestInquiryService.CreateRequestInquiry(9999, RequestInquiryCreate{
+		AmountInquired:    Amount{Value: "10.00", Currency: "EUR"},
+		CounterpartyAlias: Pointer{PType: "EMAIL", Value: "bravo@bunq.com"},
+		Description:       "Invoice 2019-001",
+	})
+	if assert.NoError(t, err) {
+		assert.NotZero(t, created.Response[0].ID.ID)
+	}
+
+	res, err := c.RequestInquiryService.GetRequestInquiry(9999, 42)
+	if assert.NoError(t, err) {
+		inquiry := res.Response[0].RequestInquiry
+		assert.Equal(t, RequestInquiryStatusAccepted, inquiry.Status)
+		assert.Equal(t, "10.00", inquiry.AmountResponded.Value)
+	}
+
+	it := c.RequestInquiryService.IterateRequestInquiries(9999, PageOptions{})
+
+	var statuses []RequestInquiryStatus
+	for it.Next(context.Background()) {
+		statuses = append(statuses, it.Item().Status)
+	}
+
+	assert.NoError(t, it.Err())
+	assert.Equal(t, []RequestInquiryStatus{RequestInquiryStatusAccepted, RequestInquiryStatusPending}, statuses)
+
+	_, err = c.RequestInquiryService.RevokeRequestInquiry(9999, 42)
+	assert.NoError(t, err)
+}
+
+func TestRequestInquiryService_RequestInquiryBatch(t *testing.T) {
+	t.Parallel()
+
+	c, fakeServer, cancel := createClientWithFakeServer(t)
+	defer cancel()
+	defer fakeServer.Close()
+
+	assert.NoError(t, c.Init())
+
+	_, err := c.RequestInquiryService.CreateRequestInquiryBatch(9999, RequestInquiryBatchCreate{
+		RequestInquiries: []RequestInquiryCreate{
+			{
+				AmountInquired:    Amount{Value: "10.00", Currency: "EUR"},
+				CounterpartyAlias: Pointer{PType: "EMAIL", Value: "bravo@bunq.com"},
+				Description:       "Invoice 2019-002",
+			},
+		},
+		TotalAmountInquired: Amount{Value: "10.00", Currency: "EUR"},
+	})
+	assert.NoError(t, err)
+
+	res, err := c.RequestInquiryService.GetRequestInquiryBatch(9999, 43)
+	if assert.NoError(t, err) {
+		batch := res.Response[0].RequestInquiryBatch
+		assert.Len(t, batch.RequestInquiries, 2)
+		assert.Equal(t, 43, batch.RequestInquiries[0].BatchID)
+	}
+
+	it := c.RequestInquiryService.IterateRequestInquiryBatches(9999, PageOptions{})
+
+	var ids []int
+	for it.Next(context.Background()) {
+		ids = append(ids, it.Item().ID)
+	}
+
+	assert.NoError(t, it.Err())
+	assert.Equal(t, []int{43}, ids)
+
+	_, err = c.RequestInquiryService.RevokeRequestInquiryBatch(9999, 43)
+	assert.NoError(t, err)
+}
+
+func TestPayment_RequestInquiryIDs(t *testing.T) {
+	t.Parallel()
+
+	p := Payment{
+		RequestReferenceSplitTheBill: []RequestReference{
+			{Type: "RequestInquiry", ID: 42},
+			{Type: "RequestInquiryBatch", ID: 43},
+			{Type: "RequestInquiry", ID: 44},
+		},
+	}
+
+	assert.Equal(t, []int{42, 44}, p.RequestInquiryIDs())
+}
@@ -1,6 +1,8 @@
 package bunq
 
 import (
+	"context"
+	"encoding/json"
 	"fmt"
 	"net/http"
 
@@ -11,12 +13,17 @@ type requestResponseService service
 
 // GetAllRequestResponses returns all request responses for a given account
 func (p *requestResponseService) GetAllRequestResponses(monetaryAccountID uint) (*ResponseRequestResponsesGet, error) {
+	return p.GetAllRequestResponsesCtx(context.Background(), monetaryAccountID)
+}
+
+// GetAllRequestResponsesCtx is GetAllRequestResponses with a context for the request.
+func (p *requestResponseService) GetAllRequestResponsesCtx(ctx context.Context, monetaryAccountID uint) (*ResponseRequestResponsesGet, error) {
 	userID, err := p.client.GetUserID()
 	if err != nil {
 		return nil, errors.Wrap(err, "bunq: request-response service: could not determine user id")
 	}
 
-	res, err := p.client.preformRequest(http.MethodGet, p.client.formatRequestURL(fmt.Sprintf(endpointRequestResponsesGet, userID, monetaryAccountID)), nil)
+	res, err := p.client.preformRequest(ctx, http.MethodGet, p.client.formatRequestURL(fmt.Sprintf(endpointRequestResponsesGet, userID, monetaryAccountID)), nil)
 	if err != nil {
 		return nil, err
 	}
@@ -28,11 +35,16 @@ func (p *requestResponseService) GetAllRequestResponses(monetaryAccountID uint)
 
 // GetAllOlderPayment calls the older url from the Pagination
 func (p *requestResponseService) GetAllOlderRequestResponses(pagi Pagination) (*ResponseRequestResponsesGet, error) {
+	return p.GetAllOlderRequestResponsesCtx(context.Background(), pagi)
+}
+
+// GetAllOlderRequestResponsesCtx is GetAllOlderRequestResponses with a context for the request.
+func (p *requestResponseService) GetAllOlderRequestResponsesCtx(ctx context.Context, pagi Pagination) (*ResponseRequestResponsesGet, error) {
 	if pagi.OlderURL == "" {
 		return nil, nil
 	}
 
-	res, err := p.client.preformRequest(http.MethodGet, p.client.formatRequestURL(pagi.OlderURL[len("/v1/"):]), nil)
+	res, err := p.client.preformRequest(ctx, http.MethodGet, p.client.formatRequestURL(pagi.OlderURL[len("/v1/"):]), nil)
 	if err != nil {
 		return nil, err
 	}
@@ -41,3 +53,60 @@ func (p *requestResponseService) GetAllOlderRequestResponses(pagi Pagination) (*
 
 	return &resStruct, p.client.parseResponse(res, &resStruct)
 }
+
+// IterateRequestResponses returns an iterator over the request responses of the given account.
+func (p *requestResponseService) IterateRequestResponses(monetaryAccountID uint, opts PageOptions) *RequestResponseIterator {
+	userID, err := p.client.GetUserID()
+	if err != nil {
+		return &RequestResponseIterator{it: newPageIteratorFromError(err)}
+	}
+
+	return &RequestResponseIterator{
+		it: newPageIterator(p.client, "RequestResponse", fmt.Sprintf(endpointRequestResponsesGet, userID, monetaryAccountID), opts),
+	}
+}
+
+// Accept pays the request response with the given amount. The addresses are only needed when the
+// request requires them, see RequestResponse.RequireAddress.
+func (p *requestResponseService) Accept(monetaryAccountID, id int, amount Amount, addressShipping, addressBilling *Address) (*responseBunqID, error) {
+	return p.AcceptCtx(context.Background(), monetaryAccountID, id, amount, addressShipping, addressBilling)
+}
+
+// AcceptCtx is Accept with a context for the request.
+func (p *requestResponseService) AcceptCtx(ctx context.Context, monetaryAccountID, id int, amount Amount, addressShipping, addressBilling *Address) (*responseBunqID, error) {
+	err := amount.Validate()
+	if err != nil {
+		return nil, err
+	}
+
+	return p.update(ctx, monetaryAccountID, id, requestRequestResponseUpdate{
+		AmountResponded: &amount,
+		Status:          RequestResponseStatusAccepted,
+		AddressShipping: addressShipping,
+		AddressBilling:  addressBilling,
+	})
+}
+
+// Reject rejects the request response.
+func (p *requestResponseService) Reject(monetaryAccountID, id int) (*responseBunqID, error) {
+	return p.RejectCtx(context.Background(), monetaryAccountID, id)
+}
+
+// RejectCtx is Reject with a context for the request.
+func (p *requestResponseService) RejectCtx(ctx context.Context, monetaryAccountID, id int) (*responseBunqID, error) {
+	return p.update(ctx, monetaryAccountID, id, requestRequestResponseUpdate{Status: RequestResponseStatusRejected})
+}
+
+func (p *requestResponseService) update(ctx context.Context, monetaryAccountID, id int, update requestRequestResponseUpdate) (*responseBunqID, error) {
+	userID, err := p.client.GetUserID()
+	if err != nil {
+		return nil, errors.Wrap(err, "bunq: request-response service: could not determine user id")
+	}
+
+	bodyRaw, err := json.Marshal(update)
+	if err != nil {
+		return nil, errors.Wrap(err, "bunq: could not marshal body")
+	}
+
+	return p.client.doCURequest(ctx, p.client.formatRequestURL(fmt.Sprintf(endpointRequestResponseWithID, userID, monetaryAccountID, id)), bodyRaw, http.MethodPut)
+}
@@ -1,6 +1,7 @@
 package bunq
 
 import (
+	"encoding/json"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -93,3 +94,34 @@ func Test_requestResponseService_GetAllOlderRequestResponses(t *testing.T) {
 		})
 	}
 }
+
+func Test_requestResponseService_AcceptAndReject(t *testing.T) {
+	t.Parallel()
+
+	c, fakeServer, cancel := createClientWithFakeServer(t)
+	defer cancel()
+	defer fakeServer.Close()
+
+	assert.NoError(t, c.Init())
+
+	_, err := c.RequestResponseService.Accept(9999, 7, Amount{Value: "10.00", Currency: "EUR"}, nil, &Address{
+		Street:      "Naritaweg",
+		HouseNumber: "131",
+		PostalCode:  "1043 BS",
+		City:        "Amsterdam",
+		Country:     "NL",
+	})
+	assert.NoError(t, err)
+
+	_, err = c.RequestResponseService.Reject(9999, 7)
+	assert.NoError(t, err)
+}
+
+func Test_requestRequestResponseUpdate_marshal(t *testing.T) {
+	t.Parallel()
+
+	raw, err := json.Marshal(requestRequestResponseUpdate{Status: RequestResponseStatusRejected})
+
+	assert.NoError(t, err)
+	assert.JSONEq(t, `{"status":"REJECTED"}`, string(raw))
+}
@@ -5,7 +5,7 @@ type responseInstallation struct {
 }
 
 type responseError struct {
-	Error []bunqError `json:"Error"`
+	Error []APIErrorDescription `json:"Error"`
 }
 
 type responseDeviceServer struct {
@@ -16,12 +16,29 @@ type responseSessionServer struct {
 	Response []sessionServer
 }
 
-type responseUserPerson struct {
+// ResponseUserPersonGet The user person response object.
+type ResponseUserPersonGet struct {
 	Response []struct {
-		UserPerson userPerson
+		UserPerson UserPerson
 	}
 }
 
+// ResponseUserCompanyGet The user company response object.
+type ResponseUserCompanyGet struct {
+	Response []struct {
+		UserCompany UserCompany `json:"UserCompany"`
+	} `json:"Response"`
+}
+
+// ResponseUserGet The user response object. Only the field of the type of the user is set.
+type ResponseUserGet struct {
+	Response []struct {
+		UserPerson  *UserPerson  `json:"UserPerson,omitempty"`
+		UserCompany *UserCompany `json:"UserCompany,omitempty"`
+		UserAPIKey  *UserAPIKey  `json:"UserApiKey,omitempty"`
+	} `json:"Response"`
+}
+
 type responseBunqID struct {
 	Response []wrappedBunqID
 }
@@ -42,10 +59,12 @@ type ResponseMonetaryAccountSavingGet struct {
 	Pagination Pagination `json:"Pagination"`
 }
 
-type responseDraftPaymentGet struct {
+// ResponseDraftPaymentGet The draft payment response object.
+type ResponseDraftPaymentGet struct {
 	Response []struct {
-		DraftPayment draftPayment `json:"DraftPayment"`
+		DraftPayment DraftPayment `json:"DraftPayment"`
 	} `json:"Response"`
+	Pagination Pagination `json:"Pagination"`
 }
 
 // ResponsePaymentGet The payment response data.
@@ -56,9 +75,17 @@ type ResponsePaymentGet struct {
 	Pagination Pagination `json:"Pagination"`
 }
 
-type responseMasterCardActionGet struct {
+// PaymentSyncResult holds the payments that were created since the previous sync.
+type PaymentSyncResult struct {
+	Payments []Payment
+	// Cursor should be persisted and passed to the next sync.
+	Cursor string
+}
+
+// ResponseMasterCardActionGet The MasterCard action response object.
+type ResponseMasterCardActionGet struct {
 	Response []struct {
-		MasterCardAction masterCardAction `json:"MasterCardAction"`
+		MasterCardAction MasterCardAction `json:"MasterCardAction"`
 	} `json:"Response"`
 	Pagination Pagination `json:"Pagination"`
 }
@@ -71,14 +98,91 @@ type ResponseScheduledPaymentsGet struct {
 	Pagination Pagination `json:"Pagination"`
 }
 
-type bunqError struct {
-	ErrorDescription           string `json:"error_description"`
-	ErrorDescriptionTranslated string `json:"error_description_translated"`
-}
-
 type ResponseRequestResponsesGet struct {
 	Response []struct {
 		RequestResponse RequestResponse `json:"RequestResponse"`
 	} `json:"Response"`
 	Pagination Pagination `json:"Pagination"`
 }
+
+// ResponseCardGet The card response object. Depending on the card type the card is stored as debit or credit card.
+type ResponseCardGet struct {
+	Response []struct {
+		CardDebit  *Card `json:"CardDebit,omitempty"`
+		CardCredit *Card `json:"CardCredit,omitempty"`
+	} `json:"Response"`
+	Pagination Pagination `json:"Pagination"`
+}
+
+// Cards returns the cards in the response regardless of their type.
+func (r *ResponseCardGet) Cards() []Card {
+	var cards []Card
+
+	for _, entry := range r.Response {
+		switch {
+		case entry.CardDebit != nil:
+			cards = append(cards, *entry.CardDebit)
+		case entry.CardCredit != nil:
+			cards = append(cards, *entry.CardCredit)
+		}
+	}
+
+	return cards
+}
+
+// ResponseNotificationFilterURLGet The notification filter url response object.
+type ResponseNotificationFilterURLGet struct {
+	Response []struct {
+		NotificationFilterURL NotificationFilterURL `json:"NotificationFilterUrl"`
+	} `json:"Response"`
+}
+
+// NotificationFilters returns the notification filters of the response.
+func (r *ResponseNotificationFilterURLGet) NotificationFilters() []NotificationFilterURL {
+	filters := make([]NotificationFilterURL, 0, len(r.Response))
+
+	for _, item := range r.Response {
+		filters = append(filters, item.NotificationFilterURL)
+	}
+
+	return filters
+}
+
+// ResponseRequestInquiryGet The request inquiry response object.
+type ResponseRequestInquiryGet struct {
+	Response []struct {
+		RequestInquiry RequestInquiry `json:"RequestInquiry"`
+	} `json:"Response"`
+	Pagination Pagination `json:"Pagination"`
+}
+
+// ResponseRequestInquiryBatchGet The request inquiry batch response object.
+type ResponseRequestInquiryBatchGet struct {
+	Response []struct {
+		RequestInquiryBatch RequestInquiryBatch `json:"RequestInquiryBatch"`
+	} `json:"Response"`
+	Pagination Pagination `json:"Pagination"`
+}
+
+// responseScheduleInstancesGet The schedule instances response object, only the iterator exposes the instances.
+type responseScheduleInstancesGet struct {
+	Response []struct {
+		ScheduleInstance ScheduleInstance `json:"ScheduleInstance"`
+	} `json:"Response"`
+	Pagination Pagination `json:"Pagination"`
+}
+
+// ResponseMonetaryAccountJointGet The monetary account joint response object.
+type ResponseMonetaryAccountJointGet struct {
+	Response []struct {
+		MonetaryAccountJoint MonetaryAccountJoint `json:"MonetaryAccountJoint"`
+	} `json:"Response"`
+	Pagination Pagination `json:"Pagination"`
+}
+
+// ResponseMonetaryAccountGet The monetary account response object, use MonetaryAccounts to get the accounts
+// regardless of their type.
+type ResponseMonetaryAccountGet struct {
+	Response   []MonetaryAccountEntry `json:"Response"`
+	Pagination Pagination             `json:"Pagination"`
+}
@@ -0,0 +1,122 @@
+package bunq
+
+import (
+	"io"
+	"io/ioutil"
+	"math/rand"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+const headerRetryAfter string = "Retry-After"
+
+// RetryPolicy describes how the client retries requests that bunq answered with a 429 or a 5xx status.
+type RetryPolicy struct {
+	// MaxAttempts is the total amount of attempts, including the first one. A value of 1 or lower disables retrying.
+	MaxAttempts int
+	// BaseDelay is the delay before the first retry, every next retry doubles it.
+	BaseDelay time.Duration
+	// MaxDelay caps the delay between two attempts. A Retry-After header sent by bunq is always honored.
+	MaxDelay time.Duration
+	// RetryNonIdempotent allows POST requests to be retried after a 5xx. The X-Bunq-Client-Request-Id
+	// is kept the same across attempts so that bunq can detect the duplicate.
+	RetryNonIdempotent bool
+}
+
+// DefaultRetryPolicy is the retry policy every new client starts with.
+var DefaultRetryPolicy = RetryPolicy{
+	MaxAttempts: 3,
+	BaseDelay:   time.Second,
+	MaxDelay:    time.Second * 30,
+}
+
+// SetRetryPolicy sets the retry policy
+func (c *Client) SetRetryPolicy(p RetryPolicy) {
+	c.retryPolicy = p
+}
+
+func (p RetryPolicy) shouldRetry(r *http.Request, res *http.Response, attempt int) bool {
+	if attempt >= p.MaxAttempts {
+		return false
+	}
+
+	switch {
+	case res.StatusCode == http.StatusTooManyRequests:
+		// A rate limited request has not been processed by bunq, so it is always safe to send it again.
+		return true
+	case res.StatusCode >= http.StatusInternalServerError:
+		return isIdempotentMethod(r.Method) || p.RetryNonIdempotent
+	default:
+		return false
+	}
+}
+
+func (p RetryPolicy) delay(res *http.Response, attempt int) time.Duration {
+	if retryAfter, ok := parseRetryAfter(res.Header.Get(headerRetryAfter)); ok {
+		return retryAfter
+	}
+
+	d := p.BaseDelay << uint(attempt-1)
+	if d <= 0 || (p.MaxDelay > 0 && d > p.MaxDelay) {
+		d = p.MaxDelay
+	}
+
+	if d <= 0 {
+		return 0
+	}
+
+	// Equal jitter, wait at least half of the delay so the backoff keeps growing.
+	half := d / 2
+
+	return half + time.Duration(rand.Int63n(int64(d-half)+1))
+}
+
+func parseRetryAfter(v string) (time.Duration, bool) {
+	if v == "" {
+		return 0, false
+	}
+
+	if sec, err := strconv.Atoi(v); err == nil && sec >= 0 {
+		return time.Duration(sec) * time.Second, true
+	}
+
+	if t, err := http.ParseTime(v); err == nil {
+		d := time.Until(t)
+		if d < 0 {
+			d = 0
+		}
+
+		return d, true
+	}
+
+	return 0, false
+}
+
+func isIdempotentMethod(method string) bool {
+	switch method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions, http.MethodPut, http.MethodDelete:
+		return true
+	default:
+		return false
+	}
+}
+
+// prepareRetry rewinds the body of the request and signs it again, the request id stays the same.
+func (c *Client) prepareRetry(r *http.Request, res *http.Response) error {
+	_, _ = io.Copy(ioutil.Discard, res.Body)
+	res.Body.Close()
+
+	if r.GetBody != nil {
+		body, err := r.GetBody()
+		if err != nil {
+			return errors.Wrap(err, "bunq: could not rewind request body")
+		}
+
+		r.Body = body
+	}
+
+	return c.setAuthHeader(r)
+}
@@ -0,0 +1,105 @@
+package bunq
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func createBunqFakeHandlerWithStatus(t *testing.T, endpoint string, status int, times int32) (http.HandlerFunc, *int32) {
+	var calls int32
+
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == endpoint && atomic.AddInt32(&calls, 1) <= times {
+			w.Header().Set(headerRetryAfter, "0")
+			sendResponseWithSignature(t, w, status, getErrorResponse(t))
+		} else {
+			createBunqFakeHandler(t)(w, r)
+		}
+	}), &calls
+}
+
+func TestRetryOnServiceUnavailable(t *testing.T) {
+	t.Parallel()
+
+	h, calls := createBunqFakeHandlerWithStatus(t, "/v1/user/6084/monetary-account/10111/payment/1", http.StatusServiceUnavailable, 2)
+	fakeServer := httptest.NewServer(h)
+	defer fakeServer.Close()
+
+	key, err := CreateNewKeyPair()
+	assert.NoError(t, err)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	c := NewClient(ctx, fmt.Sprintf("%s/v1/", fakeServer.URL), key, "", "")
+
+	assert.NoError(t, c.Init())
+
+	res, err := c.PaymentService.GetPayment(10111, 1)
+
+	if assert.NoError(t, err) {
+		assert.NotZero(t, res.Response[0].Payment.ID)
+	}
+	assert.Equal(t, int32(3), atomic.LoadInt32(calls))
+}
+
+func TestRetryGivesUpAfterMaxAttempts(t *testing.T) {
+	t.Parallel()
+
+	h, calls := createBunqFakeHandlerWithStatus(t, "/v1/user/6084/monetary-account/10111/payment/1", http.StatusTooManyRequests, 5)
+	fakeServer := httptest.NewServer(h)
+	defer fakeServer.Close()
+
+	key, err := CreateNewKeyPair()
+	assert.NoError(t, err)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	c := NewClient(ctx, fmt.Sprintf("%s/v1/", fakeServer.URL), key, "", "")
+	c.SetRetryPolicy(RetryPolicy{MaxAttempts: 2})
+
+	assert.NoError(t, c.Init())
+
+	_, err = c.PaymentService.GetPayment(10111, 1)
+
+	assert.True(t, IsRateLimited(err))
+	assert.Equal(t, int32(2), atomic.LoadInt32(calls))
+}
+
+func TestRetryPolicy_shouldRetry(t *testing.T) {
+	t.Parallel()
+
+	p := RetryPolicy{MaxAttempts: 3}
+	post, _ := http.NewRequest(http.MethodPost, BaseURLSandbox, nil)
+	get, _ := http.NewRequest(http.MethodGet, BaseURLSandbox, nil)
+
+	assert.True(t, p.shouldRetry(get, &http.Response{StatusCode: http.StatusBadGateway}, 1))
+	assert.False(t, p.shouldRetry(get, &http.Response{StatusCode: http.StatusBadGateway}, 3))
+	assert.False(t, p.shouldRetry(get, &http.Response{StatusCode: http.StatusNotFound}, 1))
+	assert.True(t, p.shouldRetry(post, &http.Response{StatusCode: http.StatusTooManyRequests}, 1))
+	assert.False(t, p.shouldRetry(post, &http.Response{StatusCode: http.StatusServiceUnavailable}, 1))
+
+	p.RetryNonIdempotent = true
+	assert.True(t, p.shouldRetry(post, &http.Response{StatusCode: http.StatusServiceUnavailable}, 1))
+}
+
+func TestParseRetryAfter(t *testing.T) {
+	t.Parallel()
+
+	d, ok := parseRetryAfter("3")
+	assert.True(t, ok)
+	assert.Equal(t, 3*time.Second, d)
+
+	_, ok = parseRetryAfter("")
+	assert.False(t, ok)
+
+	d, ok = parseRetryAfter(time.Now().Add(-time.Minute).UTC().Format(http.TimeFormat))
+	assert.True(t, ok)
+	assert.Zero(t, d)
+}
@@ -0,0 +1,207 @@
+package bunq
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+const (
+	// TimeFormat is the layout bunq uses for timestamps. The timestamps are in UTC.
+	TimeFormat string = "2006-01-02 15:04:05.000000"
+
+	scheduledPaymentStatusActive string = "ACTIVE"
+)
+
+// ParseTime parses a bunq timestamp.
+func ParseTime(s string) (time.Time, error) {
+	t, err := time.ParseInLocation(TimeFormat, s, time.UTC)
+	if err != nil {
+		return time.Time{}, errors.Wrap(err, fmt.Sprintf("bunq: could not parse time %q", s))
+	}
+
+	return t, nil
+}
+
+// FormatTime formats t as a bunq timestamp.
+func FormatTime(t time.Time) string {
+	return t.UTC().Format(TimeFormat)
+}
+
+// Valid returns true if u is one of the recurrence units bunq supports.
+func (u RecurrenceUnit) Valid() bool {
+	switch u {
+	case RecurrenceUnitOnce, RecurrenceUnitHourly, RecurrenceUnitDaily, RecurrenceUnitWeekly, RecurrenceUnitMonthly, RecurrenceUnitYearly:
+		return true
+	}
+
+	return false
+}
+
+func (s *Schedule) validateRecurrence() error {
+	if !s.RecurrenceUnit.Valid() {
+		return fmt.Errorf("bunq: unknown recurrence unit %q", s.RecurrenceUnit)
+	}
+
+	if s.RecurrenceSize < 0 || (s.RecurrenceUnit != RecurrenceUnitOnce && s.RecurrenceSize < 1) {
+		return fmt.Errorf("bunq: recurrence size must be at least 1, got %d", s.RecurrenceSize)
+	}
+
+	return nil
+}
+
+// validate checks a schedule before it is sent to bunq: the recurrence must be valid, TimeStart must be set and
+// TimeEnd, when set, may not be before TimeStart.
+func (s *Schedule) validate() error {
+	err := s.validateRecurrence()
+	if err != nil {
+		return errors.Wrap(err, "bunq: invalid schedule")
+	}
+
+	start, err := s.Start()
+	if err != nil {
+		return errors.Wrap(err, "bunq: invalid schedule start")
+	}
+
+	end, hasEnd, err := s.End()
+	if err != nil {
+		return errors.Wrap(err, "bunq: invalid schedule end")
+	}
+
+	if hasEnd && end.Before(start) {
+		return errors.New("bunq: schedule ends before it starts")
+	}
+
+	return nil
+}
+
+// Start returns the parsed TimeStart of the schedule.
+func (s *Schedule) Start() (time.Time, error) {
+	return ParseTime(s.TimeStart)
+}
+
+// End returns the parsed TimeEnd of the schedule. ok is false when the schedule has no end.
+func (s *Schedule) End() (end time.Time, ok bool, err error) {
+	if s.TimeEnd == "" {
+		return time.Time{}, false, nil
+	}
+
+	end, err = ParseTime(s.TimeEnd)
+
+	return end, err == nil, err
+}
+
+// NextOccurrences returns at most n times at or after from on which the schedule is executed, ending at TimeEnd.
+// Monthly and yearly schedules that start on a day that does not exist in every month, e.g. the 31st, are executed
+// on the last day of the shorter months.
+func (s *Schedule) NextOccurrences(from time.Time, n int) ([]time.Time, error) {
+	err := s.validateRecurrence()
+	if err != nil {
+		return nil, err
+	}
+
+	start, err := s.Start()
+	if err != nil {
+		return nil, err
+	}
+
+	end, hasEnd, err := s.End()
+	if err != nil {
+		return nil, err
+	}
+
+	var occurrences []time.Time
+
+	for k := s.firstOccurrenceIndex(start, from); len(occurrences) < n; k++ {
+		t := s.occurrence(start, k)
+
+		if hasEnd && t.After(end) {
+			break
+		}
+
+		if !t.Before(from) {
+			occurrences = append(occurrences, t)
+		}
+
+		if s.RecurrenceUnit == RecurrenceUnitOnce {
+			break
+		}
+	}
+
+	return occurrences, nil
+}
+
+// occurrence returns the k-th occurrence of the schedule. It is calculated from start rather than from the
+// previous occurrence so a month end that was moved does not shift the following occurrences.
+func (s *Schedule) occurrence(start time.Time, k int) time.Time {
+	steps := k * s.RecurrenceSize
+
+	switch s.RecurrenceUnit {
+	case RecurrenceUnitHourly:
+		return start.Add(time.Duration(steps) * time.Hour)
+	case RecurrenceUnitDaily:
+		return start.AddDate(0, 0, steps)
+	case RecurrenceUnitWeekly:
+		return start.AddDate(0, 0, steps*7)
+	case RecurrenceUnitMonthly:
+		return addMonthsClamped(start, steps)
+	case RecurrenceUnitYearly:
+		return addMonthsClamped(start, steps*12)
+	}
+
+	return start
+}
+
+// firstOccurrenceIndex returns an index of an occurrence that is not after from, so NextOccurrences does not
+// have to walk through every occurrence between start and from.
+func (s *Schedule) firstOccurrenceIndex(start, from time.Time) int {
+	if !from.After(start) || s.RecurrenceUnit == RecurrenceUnitOnce {
+		return 0
+	}
+
+	var k int
+
+	switch s.RecurrenceUnit {
+	case RecurrenceUnitHourly:
+		k = int(from.Sub(start) / time.Hour)
+	case RecurrenceUnitDaily, RecurrenceUnitWeekly:
+		k = int(from.Sub(start)/(time.Hour*24)) - 1
+		if s.RecurrenceUnit == RecurrenceUnitWeekly {
+			k /= 7
+		}
+	case RecurrenceUnitMonthly, RecurrenceUnitYearly:
+		k = (from.Year()-start.Year())*12 + int(from.Month()-start.Month()) - 1
+		if s.RecurrenceUnit == RecurrenceUnitYearly {
+			k /= 12
+		}
+	}
+
+	k /= s.RecurrenceSize
+	if k < 0 {
+		return 0
+	}
+
+	return k
+}
+
+func addMonthsClamped(t time.Time, months int) time.Time {
+	firstOfMonth := time.Date(t.Year(), t.Month()+time.Month(months), 1, t.Hour(), t.Minute(), t.Second(), t.Nanosecond(), t.Location())
+
+	day := t.Day()
+	if last := firstOfMonth.AddDate(0, 1, -1).Day(); day > last {
+		day = last
+	}
+
+	return firstOfMonth.AddDate(0, 0, day-1)
+}
+
+// NextOccurrences returns at most n times at or after from on which the scheduled payment is executed.
+// A scheduled payment that is not active has no next occurrences.
+func (p *ScheduledPayment) NextOccurrences(from time.Time, n int) ([]time.Time, error) {
+	if p.Status != "" && p.Status != scheduledPaymentStatusActive {
+		return nil, nil
+	}
+
+	return p.Schedule.NextOccurrences(from, n)
+}
@@ -0,0 +1,135 @@
+package bunq
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSchedule_NextOccurrences(t *testing.T) {
+	t.Parallel()
+
+	date := func(s string) time.Time {
+		tm, err := ParseTime(s)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		return tm
+	}
+
+	tests := []struct {
+		name     string
+		schedule Schedule
+		from     string
+		n        int
+		want     []string
+		wantErr  bool
+	}{
+		{
+			name:     "once in the future",
+			schedule: Schedule{TimeStart: "2020-07-25 07:00:00.000000", RecurrenceUnit: RecurrenceUnitOnce},
+			from:     "2020-07-01 00:00:00.000000",
+			n:        3,
+			want:     []string{"2020-07-25 07:00:00.000000"},
+		},
+		{
+			name:     "once in the past",
+			schedule: Schedule{TimeStart: "2020-07-25 07:00:00.000000", RecurrenceUnit: RecurrenceUnitOnce},
+			from:     "2020-08-01 00:00:00.000000",
+			n:        3,
+		},
+		{
+			name:     "hourly",
+			schedule: Schedule{TimeStart: "2020-07-25 07:00:00.000000", RecurrenceUnit: RecurrenceUnitHourly, RecurrenceSize: 6},
+			from:     "2020-07-26 08:00:00.000000",
+			n:        2,
+			want:     []string{"2020-07-26 13:00:00.000000", "2020-07-26 19:00:00.000000"},
+		},
+		{
+			name:     "weekly starting after from",
+			schedule: Schedule{TimeStart: "2020-07-25 07:00:00.000000", RecurrenceUnit: RecurrenceUnitWeekly, RecurrenceSize: 2},
+			from:     "2020-01-01 00:00:00.000000",
+			n:        2,
+			want:     []string{"2020-07-25 07:00:00.000000", "2020-08-08 07:00:00.000000"},
+		},
+		{
+			name:     "monthly on the last day of the month",
+			schedule: Schedule{TimeStart: "2020-01-31 07:00:00.000000", RecurrenceUnit: RecurrenceUnitMonthly, RecurrenceSize: 1},
+			from:     "2020-02-01 00:00:00.000000",
+			n:        3,
+			want:     []string{"2020-02-29 07:00:00.000000", "2020-03-31 07:00:00.000000", "2020-04-30 07:00:00.000000"},
+		},
+		{
+			name: "monthly until time end",
+			schedule: Schedule{
+				TimeStart:      "2020-07-25 07:00:24.000000",
+				TimeEnd:        "2020-10-25 07:00:24.000000",
+				RecurrenceUnit: RecurrenceUnitMonthly,
+				RecurrenceSize: 1,
+			},
+			from: "2020-08-25 07:00:24.000000",
+			n:    10,
+			want: []string{"2020-08-25 07:00:24.000000", "2020-09-25 07:00:24.000000", "2020-10-25 07:00:24.000000"},
+		},
+		{
+			name:     "yearly on a leap day",
+			schedule: Schedule{TimeStart: "2020-02-29 07:00:00.000000", RecurrenceUnit: RecurrenceUnitYearly, RecurrenceSize: 1},
+			from:     "2023-06-01 00:00:00.000000",
+			n:        2,
+			want:     []string{"2024-02-29 07:00:00.000000", "2025-02-28 07:00:00.000000"},
+		},
+		{
+			name:     "unknown unit",
+			schedule: Schedule{TimeStart: "2020-07-25 07:00:00.000000", RecurrenceUnit: "FORTNIGHTLY", RecurrenceSize: 1},
+			from:     "2020-07-01 00:00:00.000000",
+			n:        1,
+			wantErr:  true,
+		},
+		{
+			name:     "missing size",
+			schedule: Schedule{TimeStart: "2020-07-25 07:00:00.000000", RecurrenceUnit: RecurrenceUnitDaily},
+			from:     "2020-07-01 00:00:00.000000",
+			n:        1,
+			wantErr:  true,
+		},
+	}
+
+	for _, tt := range tests {
+		got, err := tt.schedule.NextOccurrences(date(tt.from), tt.n)
+		if tt.wantErr {
+			assert.Error(t, err, tt.name)
+			continue
+		}
+
+		var formatted []string
+		for _, o := range got {
+			formatted = append(formatted, FormatTime(o))
+		}
+
+		assert.NoError(t, err, tt.name)
+		assert.Equal(t, tt.want, formatted, tt.name)
+	}
+}
+
+func TestScheduledPayment_NextOccurrences(t *testing.T) {
+	t.Parallel()
+
+	res := getScheduledPaymentGet(t)
+	sp := res.Response[0].ScheduledPayment
+
+	from, _ := ParseTime("2020-12-01 00:00:00.000000")
+
+	got, err := sp.NextOccurrences(from, 2)
+	if assert.NoError(t, err) && assert.Len(t, got, 2) {
+		assert.Equal(t, "2020-12-25 07:00:24.000000", FormatTime(got[0]))
+		assert.Equal(t, "2021-01-25 07:00:24.000000", FormatTime(got[1]))
+	}
+
+	sp.Status = "CANCELLED"
+
+	got, err = sp.NextOccurrences(from, 2)
+	assert.NoError(t, err)
+	assert.Empty(t, got)
+}
@@ -1,6 +1,7 @@
 package bunq
 
 import (
+	"context"
 	"fmt"
 	"net/http"
 
@@ -9,13 +10,19 @@ import (
 
 type scheduledPaymentService service
 
+// GetAllScheduledPayments returns the first page of scheduled payments, use IterateScheduledPayments to get all of them.
 func (sp *scheduledPaymentService) GetAllScheduledPayments(monetaryAccountID int) (*ResponseScheduledPaymentsGet, error) {
+	return sp.GetAllScheduledPaymentsCtx(context.Background(), monetaryAccountID)
+}
+
+// GetAllScheduledPaymentsCtx is GetAllScheduledPayments with a context for the request.
+func (sp *scheduledPaymentService) GetAllScheduledPaymentsCtx(ctx context.Context, monetaryAccountID int) (*ResponseScheduledPaymentsGet, error) {
 	userID, err := sp.client.GetUserID()
 	if err != nil {
 		return nil, err
 	}
 
-	res, err := sp.client.preformRequest(http.MethodGet, sp.client.formatRequestURL(fmt.Sprintf(endpointScheduledPaymentGet, userID, monetaryAccountID)), nil)
+	res, err := sp.client.preformRequest(ctx, http.MethodGet, sp.client.formatRequestURL(fmt.Sprintf(endpointScheduledPaymentGet, userID, monetaryAccountID)), nil)
 	if err != nil {
 		return nil, errors.Wrap(err, "bunq: request to get all scheduled payments failed")
 	}
@@ -24,3 +31,130 @@ func (sp *scheduledPaymentService) GetAllScheduledPayments(monetaryAccountID int
 
 	return &resSpGet, sp.client.parseResponse(res, &resSpGet)
 }
+
+// IterateScheduledPayments returns an iterator over the scheduled payments of the given account.
+func (sp *scheduledPaymentService) IterateScheduledPayments(monetaryAccountID int, opts PageOptions) *ScheduledPaymentIterator {
+	userID, err := sp.client.GetUserID()
+	if err != nil {
+		return &ScheduledPaymentIterator{it: newPageIteratorFromError(err)}
+	}
+
+	return &ScheduledPaymentIterator{
+		it: newPageIterator(sp.client, "ScheduledPayment", fmt.Sprintf(endpointScheduledPaymentListing, userID, monetaryAccountID), opts),
+	}
+}
+
+// CreateScheduledPayment schedules a payment from the given account.
+func (sp *scheduledPaymentService) CreateScheduledPayment(monetaryAccountID int, create ScheduledPaymentCreate) (*responseBunqID, error) {
+	return sp.CreateScheduledPaymentCtx(context.Background(), monetaryAccountID, create)
+}
+
+// CreateScheduledPaymentCtx is CreateScheduledPayment with a context for the request.
+func (sp *scheduledPaymentService) CreateScheduledPaymentCtx(ctx context.Context, monetaryAccountID int, create ScheduledPaymentCreate) (*responseBunqID, error) {
+	err := create.validate()
+	if err != nil {
+		return nil, err
+	}
+
+	return sp.client.doUserCURequest(ctx, http.MethodPost, endpointScheduledPaymentListing, create, monetaryAccountID)
+}
+
+// GetScheduledPayment returns a specific scheduled payment for a given account.
+func (sp *scheduledPaymentService) GetScheduledPayment(monetaryAccountID, id int) (*ResponseScheduledPaymentsGet, error) {
+	return sp.GetScheduledPaymentCtx(context.Background(), monetaryAccountID, id)
+}
+
+// GetScheduledPaymentCtx is GetScheduledPayment with a context for the request.
+func (sp *scheduledPaymentService) GetScheduledPaymentCtx(ctx context.Context, monetaryAccountID, id int) (*ResponseScheduledPaymentsGet, error) {
+	userID, err := sp.client.GetUserID()
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := sp.client.preformRequest(ctx, http.MethodGet, sp.client.formatRequestURL(fmt.Sprintf(endpointScheduledPaymentWithID, userID, monetaryAccountID, id)), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var resSpGet ResponseScheduledPaymentsGet
+
+	return &resSpGet, sp.client.parseResponse(res, &resSpGet)
+}
+
+// UpdateScheduledPayment replaces the payment and schedule of a scheduled payment.
+func (sp *scheduledPaymentService) UpdateScheduledPayment(monetaryAccountID, id int, update ScheduledPaymentCreate) (*responseBunqID, error) {
+	return sp.UpdateScheduledPaymentCtx(context.Background(), monetaryAccountID, id, update)
+}
+
+// UpdateScheduledPaymentCtx is UpdateScheduledPayment with a context for the request.
+func (sp *scheduledPaymentService) UpdateScheduledPaymentCtx(ctx context.Context, monetaryAccountID, id int, update ScheduledPaymentCreate) (*responseBunqID, error) {
+	err := update.validate()
+	if err != nil {
+		return nil, err
+	}
+
+	return sp.client.doUserCURequest(ctx, http.MethodPut, endpointScheduledPaymentWithID, update, monetaryAccountID, id)
+}
+
+// CancelScheduledPayment cancels a scheduled payment, payments that were already made are not affected.
+func (sp *scheduledPaymentService) CancelScheduledPayment(monetaryAccountID, id int) (*responseBunqID, error) {
+	return sp.CancelScheduledPaymentCtx(context.Background(), monetaryAccountID, id)
+}
+
+// CancelScheduledPaymentCtx is CancelScheduledPayment with a context for the request.
+func (sp *scheduledPaymentService) CancelScheduledPaymentCtx(ctx context.Context, monetaryAccountID, id int) (*responseBunqID, error) {
+	return sp.client.doUserCURequest(ctx, http.MethodDelete, endpointScheduledPaymentWithID, nil, monetaryAccountID, id)
+}
+
+// CreateScheduledPaymentBatch schedules a number of payments from the given account.
+func (sp *scheduledPaymentService) CreateScheduledPaymentBatch(monetaryAccountID int, create ScheduledPaymentBatchCreate) (*responseBunqID, error) {
+	return sp.CreateScheduledPaymentBatchCtx(context.Background(), monetaryAccountID, create)
+}
+
+// CreateScheduledPaymentBatchCtx is CreateScheduledPaymentBatch with a context for the request.
+func (sp *scheduledPaymentService) CreateScheduledPaymentBatchCtx(ctx context.Context, monetaryAccountID int, create ScheduledPaymentBatchCreate) (*responseBunqID, error) {
+	err := create.validate()
+	if err != nil {
+		return nil, err
+	}
+
+	return sp.client.doUserCURequest(ctx, http.MethodPost, endpointScheduledPaymentBatchCreate, create, monetaryAccountID)
+}
+
+// UpdateScheduledPaymentBatch replaces the payments and schedule of a scheduled payment batch.
+func (sp *scheduledPaymentService) UpdateScheduledPaymentBatch(monetaryAccountID, id int, update ScheduledPaymentBatchCreate) (*responseBunqID, error) {
+	return sp.UpdateScheduledPaymentBatchCtx(context.Background(), monetaryAccountID, id, update)
+}
+
+// UpdateScheduledPaymentBatchCtx is UpdateScheduledPaymentBatch with a context for the request.
+func (sp *scheduledPaymentService) UpdateScheduledPaymentBatchCtx(ctx context.Context, monetaryAccountID, id int, update ScheduledPaymentBatchCreate) (*responseBunqID, error) {
+	err := update.validate()
+	if err != nil {
+		return nil, err
+	}
+
+	return sp.client.doUserCURequest(ctx, http.MethodPut, endpointScheduledPaymentBatchWithID, update, monetaryAccountID, id)
+}
+
+// CancelScheduledPaymentBatch cancels a scheduled payment batch.
+func (sp *scheduledPaymentService) CancelScheduledPaymentBatch(monetaryAccountID, id int) (*responseBunqID, error) {
+	return sp.CancelScheduledPaymentBatchCtx(context.Background(), monetaryAccountID, id)
+}
+
+// CancelScheduledPaymentBatchCtx is CancelScheduledPaymentBatch with a context for the request.
+func (sp *scheduledPaymentService) CancelScheduledPaymentBatchCtx(ctx context.Context, monetaryAccountID, id int) (*responseBunqID, error) {
+	return sp.client.doUserCURequest(ctx, http.MethodDelete, endpointScheduledPaymentBatchWithID, nil, monetaryAccountID, id)
+}
+
+// IterateScheduleInstances returns an iterator over the executions of a schedule, use
+// ScheduleInstance.Payments to get the payments that were made.
+func (sp *scheduledPaymentService) IterateScheduleInstances(monetaryAccountID, scheduleID int, opts PageOptions) *ScheduleInstanceIterator {
+	userID, err := sp.client.GetUserID()
+	if err != nil {
+		return &ScheduleInstanceIterator{it: newPageIteratorFromError(err)}
+	}
+
+	return &ScheduleInstanceIterator{
+		it: newPageIterator(sp.client, "ScheduleInstance", fmt.Sprintf(endpointScheduleInstanceListing, userID, monetaryAccountID, scheduleID), opts),
+	}
+}
@@ -1,6 +1,7 @@
 package bunq
 
 import (
+	"context"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -20,3 +21,124 @@ func TestScheduleListing(t *testing.T) {
 	assert.NoError(t, err)
 	assert.NotZero(t, res.Response[0].ScheduledPayment.MonetaryAccountID)
 }
+
+func TestScheduledPaymentCreateUpdateCancel(t *testing.T) {
+	t.Parallel()
+
+	c, fakeServer, cancel := createClientWithFakeServer(t)
+	defer cancel()
+	defer fakeServer.Close()
+
+	assert.NoError(t, c.Init())
+
+	payment := PaymentCreate{
+		Amount:            Amount{Currency: "EUR", Value: "4500.00"},
+		CounterpartyAlias: Pointer{PType: "IBAN", Value: "NL09BUNQ9900000420"},
+		Description:       "Rent",
+	}
+	schedule := Schedule{
+		TimeStart:      "2020-07-25 07:00:24.000000",
+		RecurrenceUnit: RecurrenceUnitMonthly,
+		RecurrenceSize: 1,
+	}
+
+	res, err := c.ScheduledPaymentService.CreateScheduledPayment(monetaryAccountID, ScheduledPaymentCreate{Payment: payment, Schedule: schedule})
+	if assert.NoError(t, err) {
+		assert.NotZero(t, res.Response[0].ID.ID)
+	}
+
+	resGet, err := c.ScheduledPaymentService.GetScheduledPayment(monetaryAccountID, 1)
+	if assert.NoError(t, err) {
+		assert.Equal(t, RecurrenceUnitMonthly, resGet.Response[0].ScheduledPayment.Schedule.RecurrenceUnit)
+	}
+
+	_, err = c.ScheduledPaymentService.UpdateScheduledPayment(monetaryAccountID, 1, ScheduledPaymentCreate{Payment: payment, Schedule: schedule})
+	assert.NoError(t, err)
+
+	_, err = c.ScheduledPaymentService.CancelScheduledPayment(monetaryAccountID, 1)
+	assert.NoError(t, err)
+
+	batch := ScheduledPaymentBatchCreate{Payments: []PaymentCreate{payment, payment}, Schedule: schedule}
+
+	_, err = c.ScheduledPaymentService.CreateScheduledPaymentBatch(monetaryAccountID, batch)
+	assert.NoError(t, err)
+
+	_, err = c.ScheduledPaymentService.UpdateScheduledPaymentBatch(monetaryAccountID, 2, batch)
+	assert.NoError(t, err)
+
+	_, err = c.ScheduledPaymentService.CancelScheduledPaymentBatch(monetaryAccountID, 2)
+	assert.NoError(t, err)
+}
+
+func TestScheduledPaymentInvalidSchedule(t *testing.T) {
+	t.Parallel()
+
+	c, fakeServer, cancel := createClientWithFakeServer(t)
+	defer cancel()
+	defer fakeServer.Close()
+
+	assert.NoError(t, c.Init())
+
+	payment := PaymentCreate{
+		Amount:            Amount{Currency: "EUR", Value: "4500.00"},
+		CounterpartyAlias: Pointer{PType: "IBAN", Value: "NL09BUNQ9900000420"},
+		Description:       "Rent",
+	}
+
+	tests := []struct {
+		name     string
+		schedule Schedule
+	}{
+		{name: "unknown unit", schedule: Schedule{TimeStart: "2020-07-25 07:00:24.000000", RecurrenceUnit: "FORTNIGHTLY", RecurrenceSize: 1}},
+		{name: "no start", schedule: Schedule{RecurrenceUnit: RecurrenceUnitMonthly, RecurrenceSize: 1}},
+		{name: "negative size", schedule: Schedule{TimeStart: "2020-07-25 07:00:24.000000", RecurrenceUnit: RecurrenceUnitOnce, RecurrenceSize: -1}},
+		{
+			name: "end before start",
+			schedule: Schedule{
+				TimeStart:      "2020-07-25 07:00:24.000000",
+				TimeEnd:        "2020-06-25 07:00:24.000000",
+				RecurrenceUnit: RecurrenceUnitMonthly,
+				RecurrenceSize: 1,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		_, err := c.ScheduledPaymentService.CreateScheduledPayment(monetaryAccountID, ScheduledPaymentCreate{Payment: payment, Schedule: tt.schedule})
+		assert.Error(t, err, tt.name)
+
+		_, err = c.ScheduledPaymentService.UpdateScheduledPaymentBatch(monetaryAccountID, 2, ScheduledPaymentBatchCreate{Payments: []PaymentCreate{payment}, Schedule: tt.schedule})
+		assert.Error(t, err, tt.name)
+	}
+}
+
+func TestIterateScheduleInstances(t *testing.T) {
+	t.Parallel()
+
+	c, fakeServer, cancel := createClientWithFakeServer(t)
+	defer cancel()
+	defer fakeServer.Close()
+
+	assert.NoError(t, c.Init())
+
+	it := c.ScheduledPaymentService.IterateScheduleInstances(monetaryAccountID, 1, PageOptions{})
+
+	var payments []int
+	var errs []string
+
+	for it.Next(context.Background()) {
+		instance := it.Item()
+
+		for _, p := range instance.Payments() {
+			payments = append(payments, p.ID)
+		}
+
+		for _, e := range instance.ErrorMessage {
+			errs = append(errs, e.ErrorDescription)
+		}
+	}
+
+	assert.NoError(t, it.Err())
+	assert.Equal(t, []int{2, 1, 3}, payments)
+	assert.Equal(t, []string{"Insufficient balance."}, errs)
+}
@@ -0,0 +1,109 @@
+package bunq
+
+import (
+	"sync"
+	"time"
+)
+
+const (
+	sessionRetryMinBackoff = time.Second
+	sessionRetryMaxBackoff = time.Minute * 5
+)
+
+// SessionEvents holds the callbacks the session worker calls. All callbacks are optional and
+// are called from the session worker goroutine, so they should not block.
+type SessionEvents struct {
+	// OnSessionRenewed is called after a new session has been created, with the time the session expires.
+	OnSessionRenewed func(expiresAt time.Time)
+	// OnSessionError is called when the session could not be renewed. The worker retries with a backoff.
+	OnSessionError func(err error)
+	// OnSessionClosed is called when the worker stopped, with the error of the session deletion if any.
+	OnSessionClosed func(err error)
+}
+
+// Health reports the state of the session of a client.
+type Health struct {
+	SessionExpiresAt time.Time
+	LastRenewal      time.Time
+	LastError        error
+	LastErrorAt      time.Time
+	Closed           bool
+}
+
+// sessionState is the state of the session worker that can safely be read from other goroutines.
+type sessionState struct {
+	mu     sync.RWMutex
+	events SessionEvents
+	health Health
+}
+
+// SetSessionEvents sets the callbacks for the session lifecycle. It should be called before Init.
+func (c *Client) SetSessionEvents(events SessionEvents) {
+	c.session.mu.Lock()
+	defer c.session.mu.Unlock()
+
+	c.session.events = events
+}
+
+// Health returns the current session health of the client.
+func (c *Client) Health() Health {
+	c.session.mu.RLock()
+	defer c.session.mu.RUnlock()
+
+	return c.session.health
+}
+
+func (c *Client) sessionExpiresAt(t time.Time) {
+	c.session.mu.Lock()
+	defer c.session.mu.Unlock()
+
+	c.session.health.SessionExpiresAt = t
+}
+
+func (c *Client) sessionRenewed(expiresAt time.Time) {
+	c.session.mu.Lock()
+	c.session.health.SessionExpiresAt = expiresAt
+	c.session.health.LastRenewal = time.Now().UTC()
+	cb := c.session.events.OnSessionRenewed
+	c.session.mu.Unlock()
+
+	if cb != nil {
+		cb(expiresAt)
+	}
+}
+
+func (c *Client) sessionError(err error) {
+	c.session.mu.Lock()
+	c.session.health.LastError = err
+	c.session.health.LastErrorAt = time.Now().UTC()
+	cb := c.session.events.OnSessionError
+	c.session.mu.Unlock()
+
+	if cb != nil {
+		cb(err)
+	}
+}
+
+func (c *Client) sessionClosed(err error) {
+	c.session.mu.Lock()
+	c.session.health.Closed = true
+	if err != nil {
+		c.session.health.LastError = err
+		c.session.health.LastErrorAt = time.Now().UTC()
+	}
+	cb := c.session.events.OnSessionClosed
+	c.session.mu.Unlock()
+
+	if cb != nil {
+		cb(err)
+	}
+}
+
+func nextSessionBackoff(d time.Duration) time.Duration {
+	d *= 2
+	if d > sessionRetryMaxBackoff {
+		return sessionRetryMaxBackoff
+	}
+
+	return d
+}
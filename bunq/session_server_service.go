@@ -2,6 +2,7 @@ package bunq
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"fmt"
 	"log"
@@ -80,21 +81,30 @@ func (s *sessionServerService) updateClientToken(r *responseSessionServer) {
 	}
 }
 
-func (s *sessionServerService) delete() error {
+// delete deletes the current session. It bypasses the request queue, as the queue is already stopped when the
+// client closes.
+func (s *sessionServerService) delete(ctx context.Context) error {
 	url := s.client.formatRequestURL(fmt.Sprintf("session/%d", s.client.sessionServerContext.ID.ID))
-	r, err := http.NewRequest(http.MethodDelete, url, nil)
+	r, err := http.NewRequestWithContext(ctx, http.MethodDelete, url, nil)
 	if err != nil {
 		return errors.Wrap(err, fmt.Sprintf("bunq: could not create request for  %s", url))
 	}
 
+	err = s.client.setAllNeededHeader(r)
+	if err != nil {
+		return errors.Wrap(err, "bunq: could not set all required headers")
+	}
+
 	res, err := s.client.Do(r)
 	if err != nil {
 		return errors.Wrap(err, fmt.Sprintf("bunq: request to %s failed", url))
 	}
 
+	defer res.Body.Close()
+
 	if res.StatusCode > 299 {
-		return errors.New(fmt.Sprintf("bunq: request to delete session resulted in response code: %d", res.StatusCode))
+		return newAPIError(r, res)
 	}
 
-	return err
+	return nil
 }
@@ -37,3 +37,37 @@ func TestSessionDeleteOnCancel(t *testing.T) {
 
 	time.Sleep(time.Second * 2)
 }
+
+func TestSessionEventsAndHealth(t *testing.T) {
+	t.Parallel()
+
+	c, fakeServer, cancel := createClientWithFakeServer(t)
+	defer fakeServer.Close()
+
+	closed := make(chan error, 1)
+	c.SetSessionEvents(SessionEvents{
+		OnSessionClosed: func(err error) {
+			closed <- err
+		},
+	})
+
+	assert.NoError(t, c.Init())
+
+	deadline := time.Now().Add(time.Second * 2)
+	for c.Health().SessionExpiresAt.IsZero() && time.Now().Before(deadline) {
+		time.Sleep(time.Millisecond * 10)
+	}
+
+	assert.True(t, c.Health().SessionExpiresAt.After(time.Now()))
+
+	cancel()
+
+	select {
+	case err := <-closed:
+		assert.NoError(t, err)
+	case <-time.After(time.Second * 5):
+		t.Fatal("session worker did not stop")
+	}
+
+	assert.True(t, c.Health().Closed)
+}
@@ -0,0 +1,67 @@
+package bunq
+
+// User is implemented by every type of user: *UserPerson, *UserCompany and *UserAPIKey.
+type User interface {
+	GetID() int
+	GetDisplayName() string
+	GetSessionTimeout() int64
+}
+
+// GetID returns the id of the user.
+func (u *userCommon) GetID() int { return u.ID }
+
+// GetDisplayName returns the name of the user as shown to others.
+func (u *userCommon) GetDisplayName() string { return u.DisplayName }
+
+// GetSessionTimeout returns the number of seconds a session of the user stays valid.
+func (u *userCommon) GetSessionTimeout() int64 { return u.SessionTimeout }
+
+// GetID returns the id of the API key user.
+func (k *UserAPIKey) GetID() int { return k.ID }
+
+// GetDisplayName returns the name of the user that granted the API key.
+func (k *UserAPIKey) GetDisplayName() string {
+	if u := k.GrantedByUser.User(); u != nil {
+		return u.GetDisplayName()
+	}
+
+	return ""
+}
+
+// GetSessionTimeout returns the session timeout of the user that granted the API key, a session of the API key
+// user is valid as long as a session of that user.
+func (k *UserAPIKey) GetSessionTimeout() int64 {
+	if u := k.GrantedByUser.User(); u != nil {
+		return u.GetSessionTimeout()
+	}
+
+	return 0
+}
+
+// User returns the user person or user company, or nil when neither is set.
+func (u *UserAPIKeyUser) User() User {
+	switch {
+	case u.UserPerson.ID != 0:
+		return &u.UserPerson
+	case u.UserCompany.ID != 0:
+		return &u.UserCompany
+	}
+
+	return nil
+}
+
+// User returns the user in the response regardless of its type, or nil when the response has no user.
+func (r *ResponseUserGet) User() User {
+	for _, entry := range r.Response {
+		switch {
+		case entry.UserPerson != nil:
+			return entry.UserPerson
+		case entry.UserCompany != nil:
+			return entry.UserCompany
+		case entry.UserAPIKey != nil:
+			return entry.UserAPIKey
+		}
+	}
+
+	return nil
+}
@@ -2,6 +2,7 @@ package bunq
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"fmt"
 	"net/http"
@@ -14,13 +15,18 @@ type userService service
 // GetUserPerson retrieves a signle user person. Because there can be 1 user person per api key.
 // the user id will be determined by the client.
 // https://doc.bunq.com/#/user-person/Read_UserPerson
-func (u *userService) GetUserPerson() (*responseUserPerson, error) {
+func (u *userService) GetUserPerson() (*ResponseUserPersonGet, error) {
+	return u.GetUserPersonCtx(context.Background())
+}
+
+// GetUserPersonCtx is GetUserPerson with a context for the request.
+func (u *userService) GetUserPersonCtx(ctx context.Context) (*ResponseUserPersonGet, error) {
 	userID, err := u.client.GetUserID()
 	if err != nil {
 		return nil, err
 	}
 
-	r, err := http.NewRequest(http.MethodGet, u.client.formatRequestURL(fmt.Sprintf(endpointUserPersonGet, userID)), nil)
+	r, err := http.NewRequestWithContext(ctx, http.MethodGet, u.client.formatRequestURL(fmt.Sprintf(endpointUserPersonGet, userID)), nil)
 	if err != nil {
 		return nil, errors.Wrap(err, "bunq: could not create request for user-person")
 	}
@@ -30,7 +36,7 @@ func (u *userService) GetUserPerson() (*responseUserPerson, error) {
 		return nil, errors.Wrap(err, "bunq: request to user-person failed")
 	}
 
-	var resUserPerson responseUserPerson
+	var resUserPerson ResponseUserPersonGet
 	defer res.Body.Close()
 
 	err = json.NewDecoder(res.Body).Decode(&resUserPerson)
@@ -43,7 +49,12 @@ func (u *userService) GetUserPerson() (*responseUserPerson, error) {
 
 // UpdateUserPerson updates the contents of the current auth user-person.
 // https://doc.bunq.com/#/user-person/Update_UserPerson
-func (u *userService) UpdateUserPerson(rBody requestUserPersonPut) (*responseBunqID, error) {
+func (u *userService) UpdateUserPerson(rBody UserPersonUpdate) (*responseBunqID, error) {
+	return u.UpdateUserPersonCtx(context.Background(), rBody)
+}
+
+// UpdateUserPersonCtx is UpdateUserPerson with a context for the request.
+func (u *userService) UpdateUserPersonCtx(ctx context.Context, rBody UserPersonUpdate) (*responseBunqID, error) {
 	userID, err := u.client.GetUserID()
 	if err != nil {
 		return nil, err
@@ -54,7 +65,8 @@ func (u *userService) UpdateUserPerson(rBody requestUserPersonPut) (*responseBun
 		return nil, errors.Wrap(err, "bunq: could not marshal body")
 	}
 
-	r, err := http.NewRequest(
+	r, err := http.NewRequestWithContext(
+		ctx,
 		http.MethodPut,
 		u.client.formatRequestURL(fmt.Sprintf(endpointUserPersonGet, userID)),
 		bytes.NewBuffer(bodyRaw),
@@ -78,3 +90,75 @@ func (u *userService) UpdateUserPerson(rBody requestUserPersonPut) (*responseBun
 
 	return &resBunqID, nil
 }
+
+// GetUserCompany retrieves the current auth user-company.
+// https://doc.bunq.com/#/user-company/Read_UserCompany
+func (u *userService) GetUserCompany() (*ResponseUserCompanyGet, error) {
+	return u.GetUserCompanyCtx(context.Background())
+}
+
+// GetUserCompanyCtx is GetUserCompany with a context for the request.
+func (u *userService) GetUserCompanyCtx(ctx context.Context) (*ResponseUserCompanyGet, error) {
+	userID, err := u.client.GetUserID()
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := u.client.preformRequest(ctx, http.MethodGet, u.client.formatRequestURL(fmt.Sprintf(endpointUserCompanyGet, userID)), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var resStruct ResponseUserCompanyGet
+
+	return &resStruct, u.client.parseResponse(res, &resStruct)
+}
+
+// UpdateUserCompany updates the contents of the current auth user-company.
+// https://doc.bunq.com/#/user-company/Update_UserCompany
+func (u *userService) UpdateUserCompany(update UserCompanyUpdate) (*responseBunqID, error) {
+	return u.UpdateUserCompanyCtx(context.Background(), update)
+}
+
+// UpdateUserCompanyCtx is UpdateUserCompany with a context for the request.
+func (u *userService) UpdateUserCompanyCtx(ctx context.Context, update UserCompanyUpdate) (*responseBunqID, error) {
+	err := update.validate()
+	if err != nil {
+		return nil, err
+	}
+
+	return u.client.doUserCURequest(ctx, http.MethodPut, endpointUserCompanyGet, update)
+}
+
+// GetUser retrieves the current auth user, whichever type of user the session belongs to.
+// https://doc.bunq.com/#/user/Read_User
+func (u *userService) GetUser() (User, error) {
+	return u.GetUserCtx(context.Background())
+}
+
+// GetUserCtx is GetUser with a context for the request.
+func (u *userService) GetUserCtx(ctx context.Context) (User, error) {
+	userID, err := u.client.GetUserID()
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := u.client.preformRequest(ctx, http.MethodGet, u.client.formatRequestURL(fmt.Sprintf(endpointUserGet, userID)), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var resStruct ResponseUserGet
+
+	err = u.client.parseResponse(res, &resStruct)
+	if err != nil {
+		return nil, err
+	}
+
+	user := resStruct.User()
+	if user == nil {
+		return nil, errors.New("bunq: response contains no user")
+	}
+
+	return user, nil
+}
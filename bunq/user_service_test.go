@@ -30,8 +30,8 @@ func TestUpdateUserPerson(t *testing.T) {
 
 	assert.NoError(t, c.Init())
 
-	bod := requestUserPersonPut{
-		NotificationFilters: []notificationFilter{
+	bod := UserPersonUpdate{
+		NotificationFilters: []NotificationFilter{
 			{
 				NotificationDeliveryMethod: "URL",
 				NotificationTarget:         "https://requestbin.fullcontact.com/pwgm46pw",
@@ -45,3 +45,84 @@ func TestUpdateUserPerson(t *testing.T) {
 	assert.NoError(t, err)
 	assert.NotZero(t, res.Response[0].ID.ID)
 }
+
+func TestGetUserCompany(t *testing.T) {
+	t.Parallel()
+
+	c, fakeServer, cancel := createClientWithFakeServer(t)
+	defer cancel()
+	defer fakeServer.Close()
+
+	assert.NoError(t, c.Init())
+
+	r, err := c.UserService.GetUserCompany()
+
+	if assert.NoError(t, err) {
+		company := r.Response[0].UserCompany
+
+		assert.Equal(t, 6084, company.ID)
+		assert.Equal(t, "12345678", company.ChamberOfCommerceNumber)
+		assert.Equal(t, "Jodi Barrett", company.DirectorAlias.DisplayName)
+		assert.Equal(t, "Jodi Barrett", company.UBO[0].Name)
+		assert.Equal(t, "COMPANY_V1", company.BillingContract[0].BillingContractSubscription.SubscriptionType)
+		assert.Equal(t, 25, company.CustomerLimit.LimitMonetaryAccount)
+	}
+}
+
+func TestUpdateUserCompany(t *testing.T) {
+	t.Parallel()
+
+	c, fakeServer, cancel := createClientWithFakeServer(t)
+	defer cancel()
+	defer fakeServer.Close()
+
+	assert.NoError(t, c.Init())
+
+	name := "Barrett Holding B.V."
+
+	res, err := c.UserService.UpdateUserCompany(UserCompanyUpdate{Name: &name})
+
+	assert.NoError(t, err)
+	assert.NotZero(t, res.Response[0].ID.ID)
+
+	_, err = c.UserService.UpdateUserCompany(UserCompanyUpdate{DailyLimitWithoutConfirmationLogin: &Amount{Value: "500", Currency: "EUR"}})
+	assert.Error(t, err)
+}
+
+func TestGetUser(t *testing.T) {
+	t.Parallel()
+
+	c, fakeServer, cancel := createClientWithFakeServer(t)
+	defer cancel()
+	defer fakeServer.Close()
+
+	assert.NoError(t, c.Init())
+
+	user, err := c.UserService.GetUser()
+
+	if assert.NoError(t, err) {
+		person, ok := user.(*UserPerson)
+
+		if assert.True(t, ok) {
+			assert.Equal(t, person.ID, user.GetID())
+			assert.Equal(t, person.DisplayName, user.GetDisplayName())
+		}
+
+		assert.NotZero(t, user.GetSessionTimeout())
+	}
+}
+
+func TestUserAPIKeySessionTimeout(t *testing.T) {
+	t.Parallel()
+
+	var key UserAPIKey
+
+	key.ID = 1
+	key.GrantedByUser.UserCompany.ID = 6084
+	key.GrantedByUser.UserCompany.SessionTimeout = 3600
+
+	assert.Equal(t, 1, key.GetID())
+	assert.Equal(t, int64(3600), key.GetSessionTimeout())
+
+	assert.Zero(t, (&UserAPIKey{}).GetSessionTimeout())
+}